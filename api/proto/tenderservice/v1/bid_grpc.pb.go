@@ -0,0 +1,583 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.3
+// source: tenderservice/v1/bid.proto
+
+package tenderservicev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BidService_CreateBid_FullMethodName         = "/tenderservice.v1.BidService/CreateBid"
+	BidService_ListMyBids_FullMethodName        = "/tenderservice.v1.BidService/ListMyBids"
+	BidService_ListTenderBids_FullMethodName    = "/tenderservice.v1.BidService/ListTenderBids"
+	BidService_GetBidStatus_FullMethodName      = "/tenderservice.v1.BidService/GetBidStatus"
+	BidService_UpdateBidStatus_FullMethodName   = "/tenderservice.v1.BidService/UpdateBidStatus"
+	BidService_EditBid_FullMethodName           = "/tenderservice.v1.BidService/EditBid"
+	BidService_RollbackBid_FullMethodName       = "/tenderservice.v1.BidService/RollbackBid"
+	BidService_ShortlistBid_FullMethodName      = "/tenderservice.v1.BidService/ShortlistBid"
+	BidService_WithdrawBid_FullMethodName       = "/tenderservice.v1.BidService/WithdrawBid"
+	BidService_ResubmitBid_FullMethodName       = "/tenderservice.v1.BidService/ResubmitBid"
+	BidService_SubmitBidDecision_FullMethodName = "/tenderservice.v1.BidService/SubmitBidDecision"
+	BidService_RevokeBidDecision_FullMethodName = "/tenderservice.v1.BidService/RevokeBidDecision"
+	BidService_ListBidDecisions_FullMethodName  = "/tenderservice.v1.BidService/ListBidDecisions"
+)
+
+// BidServiceClient is the client API for BidService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BidService manages bids and decisions on them.
+// Methods require username metadata of requester.
+type BidServiceClient interface {
+	CreateBid(ctx context.Context, in *CreateBidRequest, opts ...grpc.CallOption) (*Bid, error)
+	ListMyBids(ctx context.Context, in *ListMyBidsRequest, opts ...grpc.CallOption) (*ListBidsResponse, error)
+	ListTenderBids(ctx context.Context, in *ListTenderBidsRequest, opts ...grpc.CallOption) (*ListBidsResponse, error)
+	GetBidStatus(ctx context.Context, in *GetBidStatusRequest, opts ...grpc.CallOption) (*Bid, error)
+	UpdateBidStatus(ctx context.Context, in *UpdateBidStatusRequest, opts ...grpc.CallOption) (*Bid, error)
+	EditBid(ctx context.Context, in *EditBidRequest, opts ...grpc.CallOption) (*Bid, error)
+	RollbackBid(ctx context.Context, in *RollbackBidRequest, opts ...grpc.CallOption) (*Bid, error)
+	ShortlistBid(ctx context.Context, in *ShortlistBidRequest, opts ...grpc.CallOption) (*Bid, error)
+	WithdrawBid(ctx context.Context, in *WithdrawBidRequest, opts ...grpc.CallOption) (*Bid, error)
+	ResubmitBid(ctx context.Context, in *ResubmitBidRequest, opts ...grpc.CallOption) (*Bid, error)
+	SubmitBidDecision(ctx context.Context, in *SubmitBidDecisionRequest, opts ...grpc.CallOption) (*Bid, error)
+	RevokeBidDecision(ctx context.Context, in *RevokeBidDecisionRequest, opts ...grpc.CallOption) (*Bid, error)
+	ListBidDecisions(ctx context.Context, in *ListBidDecisionsRequest, opts ...grpc.CallOption) (*ListBidDecisionsResponse, error)
+}
+
+type bidServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBidServiceClient(cc grpc.ClientConnInterface) BidServiceClient {
+	return &bidServiceClient{cc}
+}
+
+func (c *bidServiceClient) CreateBid(ctx context.Context, in *CreateBidRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, BidService_CreateBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) ListMyBids(ctx context.Context, in *ListMyBidsRequest, opts ...grpc.CallOption) (*ListBidsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBidsResponse)
+	err := c.cc.Invoke(ctx, BidService_ListMyBids_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) ListTenderBids(ctx context.Context, in *ListTenderBidsRequest, opts ...grpc.CallOption) (*ListBidsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBidsResponse)
+	err := c.cc.Invoke(ctx, BidService_ListTenderBids_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) GetBidStatus(ctx context.Context, in *GetBidStatusRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, BidService_GetBidStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) UpdateBidStatus(ctx context.Context, in *UpdateBidStatusRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, BidService_UpdateBidStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) EditBid(ctx context.Context, in *EditBidRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, BidService_EditBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) RollbackBid(ctx context.Context, in *RollbackBidRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, BidService_RollbackBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) ShortlistBid(ctx context.Context, in *ShortlistBidRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, BidService_ShortlistBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) WithdrawBid(ctx context.Context, in *WithdrawBidRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, BidService_WithdrawBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) ResubmitBid(ctx context.Context, in *ResubmitBidRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, BidService_ResubmitBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) SubmitBidDecision(ctx context.Context, in *SubmitBidDecisionRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, BidService_SubmitBidDecision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) RevokeBidDecision(ctx context.Context, in *RevokeBidDecisionRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, BidService_RevokeBidDecision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) ListBidDecisions(ctx context.Context, in *ListBidDecisionsRequest, opts ...grpc.CallOption) (*ListBidDecisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBidDecisionsResponse)
+	err := c.cc.Invoke(ctx, BidService_ListBidDecisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BidServiceServer is the server API for BidService service.
+// All implementations must embed UnimplementedBidServiceServer
+// for forward compatibility.
+//
+// BidService manages bids and decisions on them.
+// Methods require username metadata of requester.
+type BidServiceServer interface {
+	CreateBid(context.Context, *CreateBidRequest) (*Bid, error)
+	ListMyBids(context.Context, *ListMyBidsRequest) (*ListBidsResponse, error)
+	ListTenderBids(context.Context, *ListTenderBidsRequest) (*ListBidsResponse, error)
+	GetBidStatus(context.Context, *GetBidStatusRequest) (*Bid, error)
+	UpdateBidStatus(context.Context, *UpdateBidStatusRequest) (*Bid, error)
+	EditBid(context.Context, *EditBidRequest) (*Bid, error)
+	RollbackBid(context.Context, *RollbackBidRequest) (*Bid, error)
+	ShortlistBid(context.Context, *ShortlistBidRequest) (*Bid, error)
+	WithdrawBid(context.Context, *WithdrawBidRequest) (*Bid, error)
+	ResubmitBid(context.Context, *ResubmitBidRequest) (*Bid, error)
+	SubmitBidDecision(context.Context, *SubmitBidDecisionRequest) (*Bid, error)
+	RevokeBidDecision(context.Context, *RevokeBidDecisionRequest) (*Bid, error)
+	ListBidDecisions(context.Context, *ListBidDecisionsRequest) (*ListBidDecisionsResponse, error)
+	mustEmbedUnimplementedBidServiceServer()
+}
+
+// UnimplementedBidServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBidServiceServer struct{}
+
+func (UnimplementedBidServiceServer) CreateBid(context.Context, *CreateBidRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBid not implemented")
+}
+func (UnimplementedBidServiceServer) ListMyBids(context.Context, *ListMyBidsRequest) (*ListBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyBids not implemented")
+}
+func (UnimplementedBidServiceServer) ListTenderBids(context.Context, *ListTenderBidsRequest) (*ListBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenderBids not implemented")
+}
+func (UnimplementedBidServiceServer) GetBidStatus(context.Context, *GetBidStatusRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBidStatus not implemented")
+}
+func (UnimplementedBidServiceServer) UpdateBidStatus(context.Context, *UpdateBidStatusRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBidStatus not implemented")
+}
+func (UnimplementedBidServiceServer) EditBid(context.Context, *EditBidRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditBid not implemented")
+}
+func (UnimplementedBidServiceServer) RollbackBid(context.Context, *RollbackBidRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackBid not implemented")
+}
+func (UnimplementedBidServiceServer) ShortlistBid(context.Context, *ShortlistBidRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShortlistBid not implemented")
+}
+func (UnimplementedBidServiceServer) WithdrawBid(context.Context, *WithdrawBidRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawBid not implemented")
+}
+func (UnimplementedBidServiceServer) ResubmitBid(context.Context, *ResubmitBidRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResubmitBid not implemented")
+}
+func (UnimplementedBidServiceServer) SubmitBidDecision(context.Context, *SubmitBidDecisionRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBidDecision not implemented")
+}
+func (UnimplementedBidServiceServer) RevokeBidDecision(context.Context, *RevokeBidDecisionRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeBidDecision not implemented")
+}
+func (UnimplementedBidServiceServer) ListBidDecisions(context.Context, *ListBidDecisionsRequest) (*ListBidDecisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBidDecisions not implemented")
+}
+func (UnimplementedBidServiceServer) mustEmbedUnimplementedBidServiceServer() {}
+func (UnimplementedBidServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeBidServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BidServiceServer will
+// result in compilation errors.
+type UnsafeBidServiceServer interface {
+	mustEmbedUnimplementedBidServiceServer()
+}
+
+func RegisterBidServiceServer(s grpc.ServiceRegistrar, srv BidServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBidServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BidService_ServiceDesc, srv)
+}
+
+func _BidService_CreateBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).CreateBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_CreateBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).CreateBid(ctx, req.(*CreateBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_ListMyBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).ListMyBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_ListMyBids_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).ListMyBids(ctx, req.(*ListMyBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_ListTenderBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenderBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).ListTenderBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_ListTenderBids_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).ListTenderBids(ctx, req.(*ListTenderBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_GetBidStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBidStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).GetBidStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_GetBidStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).GetBidStatus(ctx, req.(*GetBidStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_UpdateBidStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBidStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).UpdateBidStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_UpdateBidStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).UpdateBidStatus(ctx, req.(*UpdateBidStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_EditBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).EditBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_EditBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).EditBid(ctx, req.(*EditBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_RollbackBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).RollbackBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_RollbackBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).RollbackBid(ctx, req.(*RollbackBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_ShortlistBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShortlistBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).ShortlistBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_ShortlistBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).ShortlistBid(ctx, req.(*ShortlistBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_WithdrawBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).WithdrawBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_WithdrawBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).WithdrawBid(ctx, req.(*WithdrawBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_ResubmitBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResubmitBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).ResubmitBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_ResubmitBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).ResubmitBid(ctx, req.(*ResubmitBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_SubmitBidDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitBidDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).SubmitBidDecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_SubmitBidDecision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).SubmitBidDecision(ctx, req.(*SubmitBidDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_RevokeBidDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeBidDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).RevokeBidDecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_RevokeBidDecision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).RevokeBidDecision(ctx, req.(*RevokeBidDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_ListBidDecisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBidDecisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).ListBidDecisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_ListBidDecisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).ListBidDecisions(ctx, req.(*ListBidDecisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BidService_ServiceDesc is the grpc.ServiceDesc for BidService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BidService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tenderservice.v1.BidService",
+	HandlerType: (*BidServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBid",
+			Handler:    _BidService_CreateBid_Handler,
+		},
+		{
+			MethodName: "ListMyBids",
+			Handler:    _BidService_ListMyBids_Handler,
+		},
+		{
+			MethodName: "ListTenderBids",
+			Handler:    _BidService_ListTenderBids_Handler,
+		},
+		{
+			MethodName: "GetBidStatus",
+			Handler:    _BidService_GetBidStatus_Handler,
+		},
+		{
+			MethodName: "UpdateBidStatus",
+			Handler:    _BidService_UpdateBidStatus_Handler,
+		},
+		{
+			MethodName: "EditBid",
+			Handler:    _BidService_EditBid_Handler,
+		},
+		{
+			MethodName: "RollbackBid",
+			Handler:    _BidService_RollbackBid_Handler,
+		},
+		{
+			MethodName: "ShortlistBid",
+			Handler:    _BidService_ShortlistBid_Handler,
+		},
+		{
+			MethodName: "WithdrawBid",
+			Handler:    _BidService_WithdrawBid_Handler,
+		},
+		{
+			MethodName: "ResubmitBid",
+			Handler:    _BidService_ResubmitBid_Handler,
+		},
+		{
+			MethodName: "SubmitBidDecision",
+			Handler:    _BidService_SubmitBidDecision_Handler,
+		},
+		{
+			MethodName: "RevokeBidDecision",
+			Handler:    _BidService_RevokeBidDecision_Handler,
+		},
+		{
+			MethodName: "ListBidDecisions",
+			Handler:    _BidService_ListBidDecisions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tenderservice/v1/bid.proto",
+}
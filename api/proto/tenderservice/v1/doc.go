@@ -0,0 +1,4 @@
+// Package tenderservicev1 contains protobuf messages and grpc services of tender service generated from protos.
+package tenderservicev1
+
+//go:generate protoc -I ../.. --go_out=../.. --go_opt=paths=source_relative --go-grpc_out=../.. --go-grpc_opt=paths=source_relative tenderservice/v1/tender.proto tenderservice/v1/bid.proto tenderservice/v1/review.proto
@@ -0,0 +1,241 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.3
+// source: tenderservice/v1/review.proto
+
+package tenderservicev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BidReviewService_CreateBidReview_FullMethodName       = "/tenderservice.v1.BidReviewService/CreateBidReview"
+	BidReviewService_ListBidCreatorReviews_FullMethodName = "/tenderservice.v1.BidReviewService/ListBidCreatorReviews"
+	BidReviewService_ListBidReviews_FullMethodName        = "/tenderservice.v1.BidReviewService/ListBidReviews"
+	BidReviewService_ListMyBidReviews_FullMethodName      = "/tenderservice.v1.BidReviewService/ListMyBidReviews"
+)
+
+// BidReviewServiceClient is the client API for BidReviewService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BidReviewService manages reviews of bids by tender organization.
+// Methods require username metadata of requester.
+type BidReviewServiceClient interface {
+	CreateBidReview(ctx context.Context, in *CreateBidReviewRequest, opts ...grpc.CallOption) (*Bid, error)
+	ListBidCreatorReviews(ctx context.Context, in *ListBidCreatorReviewsRequest, opts ...grpc.CallOption) (*ListBidReviewsResponse, error)
+	ListBidReviews(ctx context.Context, in *ListBidReviewsRequest, opts ...grpc.CallOption) (*ListBidReviewsResponse, error)
+	ListMyBidReviews(ctx context.Context, in *ListMyBidReviewsRequest, opts ...grpc.CallOption) (*ListBidReviewsResponse, error)
+}
+
+type bidReviewServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBidReviewServiceClient(cc grpc.ClientConnInterface) BidReviewServiceClient {
+	return &bidReviewServiceClient{cc}
+}
+
+func (c *bidReviewServiceClient) CreateBidReview(ctx context.Context, in *CreateBidReviewRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, BidReviewService_CreateBidReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidReviewServiceClient) ListBidCreatorReviews(ctx context.Context, in *ListBidCreatorReviewsRequest, opts ...grpc.CallOption) (*ListBidReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBidReviewsResponse)
+	err := c.cc.Invoke(ctx, BidReviewService_ListBidCreatorReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidReviewServiceClient) ListBidReviews(ctx context.Context, in *ListBidReviewsRequest, opts ...grpc.CallOption) (*ListBidReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBidReviewsResponse)
+	err := c.cc.Invoke(ctx, BidReviewService_ListBidReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidReviewServiceClient) ListMyBidReviews(ctx context.Context, in *ListMyBidReviewsRequest, opts ...grpc.CallOption) (*ListBidReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBidReviewsResponse)
+	err := c.cc.Invoke(ctx, BidReviewService_ListMyBidReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BidReviewServiceServer is the server API for BidReviewService service.
+// All implementations must embed UnimplementedBidReviewServiceServer
+// for forward compatibility.
+//
+// BidReviewService manages reviews of bids by tender organization.
+// Methods require username metadata of requester.
+type BidReviewServiceServer interface {
+	CreateBidReview(context.Context, *CreateBidReviewRequest) (*Bid, error)
+	ListBidCreatorReviews(context.Context, *ListBidCreatorReviewsRequest) (*ListBidReviewsResponse, error)
+	ListBidReviews(context.Context, *ListBidReviewsRequest) (*ListBidReviewsResponse, error)
+	ListMyBidReviews(context.Context, *ListMyBidReviewsRequest) (*ListBidReviewsResponse, error)
+	mustEmbedUnimplementedBidReviewServiceServer()
+}
+
+// UnimplementedBidReviewServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBidReviewServiceServer struct{}
+
+func (UnimplementedBidReviewServiceServer) CreateBidReview(context.Context, *CreateBidReviewRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBidReview not implemented")
+}
+func (UnimplementedBidReviewServiceServer) ListBidCreatorReviews(context.Context, *ListBidCreatorReviewsRequest) (*ListBidReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBidCreatorReviews not implemented")
+}
+func (UnimplementedBidReviewServiceServer) ListBidReviews(context.Context, *ListBidReviewsRequest) (*ListBidReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBidReviews not implemented")
+}
+func (UnimplementedBidReviewServiceServer) ListMyBidReviews(context.Context, *ListMyBidReviewsRequest) (*ListBidReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyBidReviews not implemented")
+}
+func (UnimplementedBidReviewServiceServer) mustEmbedUnimplementedBidReviewServiceServer() {}
+func (UnimplementedBidReviewServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeBidReviewServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BidReviewServiceServer will
+// result in compilation errors.
+type UnsafeBidReviewServiceServer interface {
+	mustEmbedUnimplementedBidReviewServiceServer()
+}
+
+func RegisterBidReviewServiceServer(s grpc.ServiceRegistrar, srv BidReviewServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBidReviewServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BidReviewService_ServiceDesc, srv)
+}
+
+func _BidReviewService_CreateBidReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBidReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidReviewServiceServer).CreateBidReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidReviewService_CreateBidReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidReviewServiceServer).CreateBidReview(ctx, req.(*CreateBidReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidReviewService_ListBidCreatorReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBidCreatorReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidReviewServiceServer).ListBidCreatorReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidReviewService_ListBidCreatorReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidReviewServiceServer).ListBidCreatorReviews(ctx, req.(*ListBidCreatorReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidReviewService_ListBidReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBidReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidReviewServiceServer).ListBidReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidReviewService_ListBidReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidReviewServiceServer).ListBidReviews(ctx, req.(*ListBidReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidReviewService_ListMyBidReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyBidReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidReviewServiceServer).ListMyBidReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidReviewService_ListMyBidReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidReviewServiceServer).ListMyBidReviews(ctx, req.(*ListMyBidReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BidReviewService_ServiceDesc is the grpc.ServiceDesc for BidReviewService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BidReviewService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tenderservice.v1.BidReviewService",
+	HandlerType: (*BidReviewServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBidReview",
+			Handler:    _BidReviewService_CreateBidReview_Handler,
+		},
+		{
+			MethodName: "ListBidCreatorReviews",
+			Handler:    _BidReviewService_ListBidCreatorReviews_Handler,
+		},
+		{
+			MethodName: "ListBidReviews",
+			Handler:    _BidReviewService_ListBidReviews_Handler,
+		},
+		{
+			MethodName: "ListMyBidReviews",
+			Handler:    _BidReviewService_ListMyBidReviews_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tenderservice/v1/review.proto",
+}
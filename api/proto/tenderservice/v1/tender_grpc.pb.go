@@ -0,0 +1,773 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.3
+// source: tenderservice/v1/tender.proto
+
+package tenderservicev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TenderService_ListTenders_FullMethodName              = "/tenderservice.v1.TenderService/ListTenders"
+	TenderService_ListTenderTags_FullMethodName           = "/tenderservice.v1.TenderService/ListTenderTags"
+	TenderService_CreateTender_FullMethodName             = "/tenderservice.v1.TenderService/CreateTender"
+	TenderService_ListMyTenders_FullMethodName            = "/tenderservice.v1.TenderService/ListMyTenders"
+	TenderService_ListInvitedTenders_FullMethodName       = "/tenderservice.v1.TenderService/ListInvitedTenders"
+	TenderService_GetTenderStatus_FullMethodName          = "/tenderservice.v1.TenderService/GetTenderStatus"
+	TenderService_UpdateTenderStatus_FullMethodName       = "/tenderservice.v1.TenderService/UpdateTenderStatus"
+	TenderService_EditTender_FullMethodName               = "/tenderservice.v1.TenderService/EditTender"
+	TenderService_RollbackTender_FullMethodName           = "/tenderservice.v1.TenderService/RollbackTender"
+	TenderService_NextTenderRound_FullMethodName          = "/tenderservice.v1.TenderService/NextTenderRound"
+	TenderService_CloneTender_FullMethodName              = "/tenderservice.v1.TenderService/CloneTender"
+	TenderService_CreateTenderInvitation_FullMethodName   = "/tenderservice.v1.TenderService/CreateTenderInvitation"
+	TenderService_ListTenderInvitations_FullMethodName    = "/tenderservice.v1.TenderService/ListTenderInvitations"
+	TenderService_DeleteTenderInvitation_FullMethodName   = "/tenderservice.v1.TenderService/DeleteTenderInvitation"
+	TenderService_CreateTenderTemplate_FullMethodName     = "/tenderservice.v1.TenderService/CreateTenderTemplate"
+	TenderService_ListTenderTemplates_FullMethodName      = "/tenderservice.v1.TenderService/ListTenderTemplates"
+	TenderService_CreateTenderFromTemplate_FullMethodName = "/tenderservice.v1.TenderService/CreateTenderFromTemplate"
+	TenderService_DeleteTenderTemplate_FullMethodName     = "/tenderservice.v1.TenderService/DeleteTenderTemplate"
+)
+
+// TenderServiceClient is the client API for TenderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TenderService manages tenders, their invitations and templates.
+// Methods, except public listings, require username metadata of requester.
+type TenderServiceClient interface {
+	ListTenders(ctx context.Context, in *ListTendersRequest, opts ...grpc.CallOption) (*ListTendersResponse, error)
+	ListTenderTags(ctx context.Context, in *ListTenderTagsRequest, opts ...grpc.CallOption) (*ListTenderTagsResponse, error)
+	CreateTender(ctx context.Context, in *CreateTenderRequest, opts ...grpc.CallOption) (*Tender, error)
+	ListMyTenders(ctx context.Context, in *ListMyTendersRequest, opts ...grpc.CallOption) (*ListTendersResponse, error)
+	ListInvitedTenders(ctx context.Context, in *ListInvitedTendersRequest, opts ...grpc.CallOption) (*ListTendersResponse, error)
+	GetTenderStatus(ctx context.Context, in *GetTenderStatusRequest, opts ...grpc.CallOption) (*Tender, error)
+	UpdateTenderStatus(ctx context.Context, in *UpdateTenderStatusRequest, opts ...grpc.CallOption) (*Tender, error)
+	EditTender(ctx context.Context, in *EditTenderRequest, opts ...grpc.CallOption) (*Tender, error)
+	RollbackTender(ctx context.Context, in *RollbackTenderRequest, opts ...grpc.CallOption) (*Tender, error)
+	NextTenderRound(ctx context.Context, in *NextTenderRoundRequest, opts ...grpc.CallOption) (*Tender, error)
+	CloneTender(ctx context.Context, in *CloneTenderRequest, opts ...grpc.CallOption) (*Tender, error)
+	CreateTenderInvitation(ctx context.Context, in *CreateTenderInvitationRequest, opts ...grpc.CallOption) (*TenderInvitation, error)
+	ListTenderInvitations(ctx context.Context, in *ListTenderInvitationsRequest, opts ...grpc.CallOption) (*ListTenderInvitationsResponse, error)
+	DeleteTenderInvitation(ctx context.Context, in *DeleteTenderInvitationRequest, opts ...grpc.CallOption) (*TenderInvitation, error)
+	CreateTenderTemplate(ctx context.Context, in *CreateTenderTemplateRequest, opts ...grpc.CallOption) (*TenderTemplate, error)
+	ListTenderTemplates(ctx context.Context, in *ListTenderTemplatesRequest, opts ...grpc.CallOption) (*ListTenderTemplatesResponse, error)
+	CreateTenderFromTemplate(ctx context.Context, in *CreateTenderFromTemplateRequest, opts ...grpc.CallOption) (*Tender, error)
+	DeleteTenderTemplate(ctx context.Context, in *DeleteTenderTemplateRequest, opts ...grpc.CallOption) (*TenderTemplate, error)
+}
+
+type tenderServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTenderServiceClient(cc grpc.ClientConnInterface) TenderServiceClient {
+	return &tenderServiceClient{cc}
+}
+
+func (c *tenderServiceClient) ListTenders(ctx context.Context, in *ListTendersRequest, opts ...grpc.CallOption) (*ListTendersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTendersResponse)
+	err := c.cc.Invoke(ctx, TenderService_ListTenders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) ListTenderTags(ctx context.Context, in *ListTenderTagsRequest, opts ...grpc.CallOption) (*ListTenderTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenderTagsResponse)
+	err := c.cc.Invoke(ctx, TenderService_ListTenderTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) CreateTender(ctx context.Context, in *CreateTenderRequest, opts ...grpc.CallOption) (*Tender, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tender)
+	err := c.cc.Invoke(ctx, TenderService_CreateTender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) ListMyTenders(ctx context.Context, in *ListMyTendersRequest, opts ...grpc.CallOption) (*ListTendersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTendersResponse)
+	err := c.cc.Invoke(ctx, TenderService_ListMyTenders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) ListInvitedTenders(ctx context.Context, in *ListInvitedTendersRequest, opts ...grpc.CallOption) (*ListTendersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTendersResponse)
+	err := c.cc.Invoke(ctx, TenderService_ListInvitedTenders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) GetTenderStatus(ctx context.Context, in *GetTenderStatusRequest, opts ...grpc.CallOption) (*Tender, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tender)
+	err := c.cc.Invoke(ctx, TenderService_GetTenderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) UpdateTenderStatus(ctx context.Context, in *UpdateTenderStatusRequest, opts ...grpc.CallOption) (*Tender, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tender)
+	err := c.cc.Invoke(ctx, TenderService_UpdateTenderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) EditTender(ctx context.Context, in *EditTenderRequest, opts ...grpc.CallOption) (*Tender, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tender)
+	err := c.cc.Invoke(ctx, TenderService_EditTender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) RollbackTender(ctx context.Context, in *RollbackTenderRequest, opts ...grpc.CallOption) (*Tender, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tender)
+	err := c.cc.Invoke(ctx, TenderService_RollbackTender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) NextTenderRound(ctx context.Context, in *NextTenderRoundRequest, opts ...grpc.CallOption) (*Tender, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tender)
+	err := c.cc.Invoke(ctx, TenderService_NextTenderRound_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) CloneTender(ctx context.Context, in *CloneTenderRequest, opts ...grpc.CallOption) (*Tender, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tender)
+	err := c.cc.Invoke(ctx, TenderService_CloneTender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) CreateTenderInvitation(ctx context.Context, in *CreateTenderInvitationRequest, opts ...grpc.CallOption) (*TenderInvitation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenderInvitation)
+	err := c.cc.Invoke(ctx, TenderService_CreateTenderInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) ListTenderInvitations(ctx context.Context, in *ListTenderInvitationsRequest, opts ...grpc.CallOption) (*ListTenderInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenderInvitationsResponse)
+	err := c.cc.Invoke(ctx, TenderService_ListTenderInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) DeleteTenderInvitation(ctx context.Context, in *DeleteTenderInvitationRequest, opts ...grpc.CallOption) (*TenderInvitation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenderInvitation)
+	err := c.cc.Invoke(ctx, TenderService_DeleteTenderInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) CreateTenderTemplate(ctx context.Context, in *CreateTenderTemplateRequest, opts ...grpc.CallOption) (*TenderTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenderTemplate)
+	err := c.cc.Invoke(ctx, TenderService_CreateTenderTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) ListTenderTemplates(ctx context.Context, in *ListTenderTemplatesRequest, opts ...grpc.CallOption) (*ListTenderTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenderTemplatesResponse)
+	err := c.cc.Invoke(ctx, TenderService_ListTenderTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) CreateTenderFromTemplate(ctx context.Context, in *CreateTenderFromTemplateRequest, opts ...grpc.CallOption) (*Tender, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tender)
+	err := c.cc.Invoke(ctx, TenderService_CreateTenderFromTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) DeleteTenderTemplate(ctx context.Context, in *DeleteTenderTemplateRequest, opts ...grpc.CallOption) (*TenderTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenderTemplate)
+	err := c.cc.Invoke(ctx, TenderService_DeleteTenderTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TenderServiceServer is the server API for TenderService service.
+// All implementations must embed UnimplementedTenderServiceServer
+// for forward compatibility.
+//
+// TenderService manages tenders, their invitations and templates.
+// Methods, except public listings, require username metadata of requester.
+type TenderServiceServer interface {
+	ListTenders(context.Context, *ListTendersRequest) (*ListTendersResponse, error)
+	ListTenderTags(context.Context, *ListTenderTagsRequest) (*ListTenderTagsResponse, error)
+	CreateTender(context.Context, *CreateTenderRequest) (*Tender, error)
+	ListMyTenders(context.Context, *ListMyTendersRequest) (*ListTendersResponse, error)
+	ListInvitedTenders(context.Context, *ListInvitedTendersRequest) (*ListTendersResponse, error)
+	GetTenderStatus(context.Context, *GetTenderStatusRequest) (*Tender, error)
+	UpdateTenderStatus(context.Context, *UpdateTenderStatusRequest) (*Tender, error)
+	EditTender(context.Context, *EditTenderRequest) (*Tender, error)
+	RollbackTender(context.Context, *RollbackTenderRequest) (*Tender, error)
+	NextTenderRound(context.Context, *NextTenderRoundRequest) (*Tender, error)
+	CloneTender(context.Context, *CloneTenderRequest) (*Tender, error)
+	CreateTenderInvitation(context.Context, *CreateTenderInvitationRequest) (*TenderInvitation, error)
+	ListTenderInvitations(context.Context, *ListTenderInvitationsRequest) (*ListTenderInvitationsResponse, error)
+	DeleteTenderInvitation(context.Context, *DeleteTenderInvitationRequest) (*TenderInvitation, error)
+	CreateTenderTemplate(context.Context, *CreateTenderTemplateRequest) (*TenderTemplate, error)
+	ListTenderTemplates(context.Context, *ListTenderTemplatesRequest) (*ListTenderTemplatesResponse, error)
+	CreateTenderFromTemplate(context.Context, *CreateTenderFromTemplateRequest) (*Tender, error)
+	DeleteTenderTemplate(context.Context, *DeleteTenderTemplateRequest) (*TenderTemplate, error)
+	mustEmbedUnimplementedTenderServiceServer()
+}
+
+// UnimplementedTenderServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTenderServiceServer struct{}
+
+func (UnimplementedTenderServiceServer) ListTenders(context.Context, *ListTendersRequest) (*ListTendersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenders not implemented")
+}
+func (UnimplementedTenderServiceServer) ListTenderTags(context.Context, *ListTenderTagsRequest) (*ListTenderTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenderTags not implemented")
+}
+func (UnimplementedTenderServiceServer) CreateTender(context.Context, *CreateTenderRequest) (*Tender, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTender not implemented")
+}
+func (UnimplementedTenderServiceServer) ListMyTenders(context.Context, *ListMyTendersRequest) (*ListTendersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyTenders not implemented")
+}
+func (UnimplementedTenderServiceServer) ListInvitedTenders(context.Context, *ListInvitedTendersRequest) (*ListTendersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvitedTenders not implemented")
+}
+func (UnimplementedTenderServiceServer) GetTenderStatus(context.Context, *GetTenderStatusRequest) (*Tender, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenderStatus not implemented")
+}
+func (UnimplementedTenderServiceServer) UpdateTenderStatus(context.Context, *UpdateTenderStatusRequest) (*Tender, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenderStatus not implemented")
+}
+func (UnimplementedTenderServiceServer) EditTender(context.Context, *EditTenderRequest) (*Tender, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditTender not implemented")
+}
+func (UnimplementedTenderServiceServer) RollbackTender(context.Context, *RollbackTenderRequest) (*Tender, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackTender not implemented")
+}
+func (UnimplementedTenderServiceServer) NextTenderRound(context.Context, *NextTenderRoundRequest) (*Tender, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextTenderRound not implemented")
+}
+func (UnimplementedTenderServiceServer) CloneTender(context.Context, *CloneTenderRequest) (*Tender, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneTender not implemented")
+}
+func (UnimplementedTenderServiceServer) CreateTenderInvitation(context.Context, *CreateTenderInvitationRequest) (*TenderInvitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTenderInvitation not implemented")
+}
+func (UnimplementedTenderServiceServer) ListTenderInvitations(context.Context, *ListTenderInvitationsRequest) (*ListTenderInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenderInvitations not implemented")
+}
+func (UnimplementedTenderServiceServer) DeleteTenderInvitation(context.Context, *DeleteTenderInvitationRequest) (*TenderInvitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTenderInvitation not implemented")
+}
+func (UnimplementedTenderServiceServer) CreateTenderTemplate(context.Context, *CreateTenderTemplateRequest) (*TenderTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTenderTemplate not implemented")
+}
+func (UnimplementedTenderServiceServer) ListTenderTemplates(context.Context, *ListTenderTemplatesRequest) (*ListTenderTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenderTemplates not implemented")
+}
+func (UnimplementedTenderServiceServer) CreateTenderFromTemplate(context.Context, *CreateTenderFromTemplateRequest) (*Tender, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTenderFromTemplate not implemented")
+}
+func (UnimplementedTenderServiceServer) DeleteTenderTemplate(context.Context, *DeleteTenderTemplateRequest) (*TenderTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTenderTemplate not implemented")
+}
+func (UnimplementedTenderServiceServer) mustEmbedUnimplementedTenderServiceServer() {}
+func (UnimplementedTenderServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeTenderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TenderServiceServer will
+// result in compilation errors.
+type UnsafeTenderServiceServer interface {
+	mustEmbedUnimplementedTenderServiceServer()
+}
+
+func RegisterTenderServiceServer(s grpc.ServiceRegistrar, srv TenderServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTenderServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TenderService_ServiceDesc, srv)
+}
+
+func _TenderService_ListTenders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTendersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).ListTenders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_ListTenders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).ListTenders(ctx, req.(*ListTendersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_ListTenderTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenderTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).ListTenderTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_ListTenderTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).ListTenderTags(ctx, req.(*ListTenderTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_CreateTender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).CreateTender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_CreateTender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).CreateTender(ctx, req.(*CreateTenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_ListMyTenders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyTendersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).ListMyTenders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_ListMyTenders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).ListMyTenders(ctx, req.(*ListMyTendersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_ListInvitedTenders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitedTendersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).ListInvitedTenders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_ListInvitedTenders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).ListInvitedTenders(ctx, req.(*ListInvitedTendersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_GetTenderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).GetTenderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_GetTenderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).GetTenderStatus(ctx, req.(*GetTenderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_UpdateTenderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTenderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).UpdateTenderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_UpdateTenderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).UpdateTenderStatus(ctx, req.(*UpdateTenderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_EditTender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditTenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).EditTender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_EditTender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).EditTender(ctx, req.(*EditTenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_RollbackTender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackTenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).RollbackTender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_RollbackTender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).RollbackTender(ctx, req.(*RollbackTenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_NextTenderRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextTenderRoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).NextTenderRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_NextTenderRound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).NextTenderRound(ctx, req.(*NextTenderRoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_CloneTender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneTenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).CloneTender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_CloneTender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).CloneTender(ctx, req.(*CloneTenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_CreateTenderInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenderInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).CreateTenderInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_CreateTenderInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).CreateTenderInvitation(ctx, req.(*CreateTenderInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_ListTenderInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenderInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).ListTenderInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_ListTenderInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).ListTenderInvitations(ctx, req.(*ListTenderInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_DeleteTenderInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTenderInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).DeleteTenderInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_DeleteTenderInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).DeleteTenderInvitation(ctx, req.(*DeleteTenderInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_CreateTenderTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenderTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).CreateTenderTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_CreateTenderTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).CreateTenderTemplate(ctx, req.(*CreateTenderTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_ListTenderTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenderTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).ListTenderTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_ListTenderTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).ListTenderTemplates(ctx, req.(*ListTenderTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_CreateTenderFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenderFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).CreateTenderFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_CreateTenderFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).CreateTenderFromTemplate(ctx, req.(*CreateTenderFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_DeleteTenderTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTenderTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).DeleteTenderTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_DeleteTenderTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).DeleteTenderTemplate(ctx, req.(*DeleteTenderTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TenderService_ServiceDesc is the grpc.ServiceDesc for TenderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TenderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tenderservice.v1.TenderService",
+	HandlerType: (*TenderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTenders",
+			Handler:    _TenderService_ListTenders_Handler,
+		},
+		{
+			MethodName: "ListTenderTags",
+			Handler:    _TenderService_ListTenderTags_Handler,
+		},
+		{
+			MethodName: "CreateTender",
+			Handler:    _TenderService_CreateTender_Handler,
+		},
+		{
+			MethodName: "ListMyTenders",
+			Handler:    _TenderService_ListMyTenders_Handler,
+		},
+		{
+			MethodName: "ListInvitedTenders",
+			Handler:    _TenderService_ListInvitedTenders_Handler,
+		},
+		{
+			MethodName: "GetTenderStatus",
+			Handler:    _TenderService_GetTenderStatus_Handler,
+		},
+		{
+			MethodName: "UpdateTenderStatus",
+			Handler:    _TenderService_UpdateTenderStatus_Handler,
+		},
+		{
+			MethodName: "EditTender",
+			Handler:    _TenderService_EditTender_Handler,
+		},
+		{
+			MethodName: "RollbackTender",
+			Handler:    _TenderService_RollbackTender_Handler,
+		},
+		{
+			MethodName: "NextTenderRound",
+			Handler:    _TenderService_NextTenderRound_Handler,
+		},
+		{
+			MethodName: "CloneTender",
+			Handler:    _TenderService_CloneTender_Handler,
+		},
+		{
+			MethodName: "CreateTenderInvitation",
+			Handler:    _TenderService_CreateTenderInvitation_Handler,
+		},
+		{
+			MethodName: "ListTenderInvitations",
+			Handler:    _TenderService_ListTenderInvitations_Handler,
+		},
+		{
+			MethodName: "DeleteTenderInvitation",
+			Handler:    _TenderService_DeleteTenderInvitation_Handler,
+		},
+		{
+			MethodName: "CreateTenderTemplate",
+			Handler:    _TenderService_CreateTenderTemplate_Handler,
+		},
+		{
+			MethodName: "ListTenderTemplates",
+			Handler:    _TenderService_ListTenderTemplates_Handler,
+		},
+		{
+			MethodName: "CreateTenderFromTemplate",
+			Handler:    _TenderService_CreateTenderFromTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteTenderTemplate",
+			Handler:    _TenderService_DeleteTenderTemplate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tenderservice/v1/tender.proto",
+}
@@ -8,18 +8,27 @@ import (
 	"syscall"
 	"time"
 
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/metrics"
 	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/repo"
 	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/service"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/transport/grpc"
 	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/transport/http"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/cache"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/grpcserver"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/health"
 	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/httpserver"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/idempotency"
 	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/postgres"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/ratelimit"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/tracing"
 )
 
 func Run() int {
-	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGKILL)
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	slog.SetDefault(logger)
 
 	// app configuration
 	cfg, err := NewConfig()
@@ -28,6 +37,21 @@ func Run() int {
 		return 1
 	}
 
+	// tracing initialization
+	tr, err := tracing.New(ctx, cfg.Tracing.Exporter, tracing.ServiceName("tender-service"))
+	if err != nil {
+		logger.Error("failed to initialize tracing", "err", err)
+		return 1
+	}
+	defer func() {
+		tctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := tr.Shutdown(tctx); err != nil {
+			logger.Error("failed to shutdown tracing", "err", err)
+		}
+	}()
+	logger.Info("tracing initialized", "exporter", cfg.Tracing.Exporter)
+
 	// run migrations
 	if code := Migrate(cfg, logger); code != 0 {
 		return code
@@ -36,8 +60,12 @@ func Run() int {
 	// database connection
 	pg, err := postgres.New(ctx, cfg.Postgres.Conn, postgres.DataTypes([]string{
 		"organization_type",
-		"tender_service_type",
 		"tender_status",
+		"tender_visibility",
+		"tender_answer_visibility",
+		"custom_field_type",
+		"custom_field_target",
+		"notification_event",
 		"bid_status",
 		"bid_author_type",
 		"bid_decision_type"}))
@@ -51,33 +79,132 @@ func Run() int {
 	}()
 	logger.Info("db conn established", "uri", cfg.Postgres.Conn)
 
+	// health checks initialization
+	healthChecks := []health.Option{health.Check("postgres", pg.Ping)}
+	migrationCheck, err := MigrationCheck(cfg, pg)
+	if err != nil {
+		logger.Error("failed to initialize migration check", "err", err)
+		return 1
+	}
+	if migrationCheck != nil {
+		healthChecks = append(healthChecks, health.Check("migrations", migrationCheck))
+	}
+	appHealth := health.New(healthChecks...)
+
+	// rate limiter initialization
+	limiter := ratelimit.NewMemory()
+	if cfg.RateLimit.Store == RateLimitStorePostgres {
+		limiter = ratelimit.NewPostgres(pg)
+	}
+	logger.Info("rate limiter initialized", "store", cfg.RateLimit.Store)
+
+	// idempotency keys store initialization
+	idempotencyStore := idempotency.NewPostgres(pg)
+
+	// metrics initialization
+	appMetrics := metrics.New(postgres.NewCollector(pg))
+
+	// cache initialization
+	var appCache cache.Cache
+	switch cfg.Cache.Backend {
+	case CacheBackendLRU:
+		appCache = cache.NewLRU(cfg.Cache.Size)
+	case CacheBackendRedis:
+		appCache, err = cache.NewRedis(ctx, cfg.Cache.RedisURL)
+		if err != nil {
+			logger.Error("failed to connect to redis", "err", err)
+			return 1
+		}
+	}
+	if appCache != nil {
+		defer appCache.Close()
+	}
+	logger.Info("cache initialized", "backend", cfg.Cache.Backend, "ttl", cfg.Cache.TTL)
+
 	// repositories initialization
 	employeeRepo := repo.NewEmployeePG(pg)
 	tenderRepo := repo.NewTenderPG(pg)
+	if appCache != nil {
+		employeeRepo = repo.NewEmployeeCache(employeeRepo, appCache, cfg.Cache.TTL)
+		tenderRepo = repo.NewTenderCache(tenderRepo, appCache, cfg.Cache.TTL)
+	}
+	invitationRepo := repo.NewTenderInvitationPG(pg)
+	templateRepo := repo.NewTenderTemplatePG(pg)
 	bidRepo := repo.NewBidPG(pg)
 	decisionRepo := repo.NewBidDecisionPG(pg)
 	reviewRepo := repo.NewBidReviewPG(pg)
+	shortlistRepo := repo.NewBidShortlistPG(pg)
+	questionRepo := repo.NewTenderQuestionPG(pg)
+	answerRepo := repo.NewTenderAnswerPG(pg)
+	categoryRepo := repo.NewServiceCategoryPG(pg)
+	fieldRepo := repo.NewCustomFieldPG(pg)
+	watchRepo := repo.NewTenderWatchPG(pg)
+	searchRepo := repo.NewTenderSearchPG(pg)
+	notificationRepo := repo.NewNotificationPG(pg)
+	transactor := repo.NewTransactorPG(pg)
 	logger.Info("repositories initialized")
 
 	// services initialization
-	employeeService := service.NewEmployeeV1(employeeRepo)
-	tenderService := service.NewTenderV1(tenderRepo, employeeService)
-	bidService := service.NewBidV1(bidRepo, decisionRepo, tenderService, employeeService)
+	employeeService := service.NewEmployeeV1(employeeRepo, cfg.Admin.Usernames)
+	if appCache != nil {
+		employeeService = service.NewEmployeeCache(employeeService, appCache, cfg.Cache.TTL)
+	}
+	categoryService := service.NewServiceCategoryV1(categoryRepo, employeeService)
+	fieldService := service.NewCustomFieldV1(fieldRepo, employeeService)
+	matcher := service.NewTenderMatcherV1(tenderRepo, watchRepo, searchRepo,
+		service.NewNotificationChannelInApp(notificationRepo))
+	tenderService := metrics.NewTender(service.NewTenderV1(tenderRepo, invitationRepo, templateRepo, shortlistRepo,
+		categoryService, fieldService, employeeService, matcher, transactor, cfg.Tender.MaxOpen), appMetrics)
+	bidService := metrics.NewBid(service.NewBidV1(bidRepo, decisionRepo, shortlistRepo, tenderService, fieldService,
+		employeeService, transactor, cfg.Bid.RejectRule), appMetrics)
 	reviewService := service.NewBidReviewV1(reviewRepo, bidService, tenderService, employeeService)
+	questionService := service.NewTenderQuestionV1(questionRepo, answerRepo, tenderService, employeeService,
+		service.NewTenderQuestionNotifierLog())
+	batchService := service.NewBatchV1(transactor, tenderService, bidService)
+	watchService := service.NewTenderWatchV1(watchRepo, searchRepo, tenderService, categoryService, employeeService)
+	notificationService := service.NewNotificationV1(notificationRepo, employeeService)
 	logger.Info("services initialized")
 
+	// tender deadline notifications
+	go CheckDeadlines(ctx, cfg, matcher, logger)
+
 	// http server start
-	mux := http.NewMux(tenderService, bidService, reviewService, logger)
+	mux := http.NewMux(tenderService, bidService, reviewService, questionService, batchService, categoryService,
+		fieldService, watchService, notificationService, employeeService, appHealth, idempotencyStore, limiter,
+		cfg.RateLimit.Limits, appMetrics, logger)
 	server := httpserver.New(mux,
 		httpserver.Addr(cfg.Server.Addr),
 		httpserver.ReadTimeout(5*time.Second),
 		httpserver.WriteTimeout(5*time.Second))
-	server.Start(ctx)
+	// requests must not be canceled by shutdown signal, server shutdown waits for them
+	server.Start(context.WithoutCancel(ctx))
 	logger.Info("http server started", "addr", cfg.Server.Addr)
 
+	// admin http server start
+	adminServer := httpserver.New(http.NewAdminMux(appHealth, appMetrics, logger),
+		httpserver.Addr(cfg.Server.AdminAddr),
+		httpserver.ReadTimeout(5*time.Second),
+		httpserver.WriteTimeout(5*time.Second))
+	adminServer.Start(context.WithoutCancel(ctx))
+	logger.Info("admin http server started", "addr", cfg.Server.AdminAddr)
+
+	// grpc server start
+	grpcServer := grpcserver.New(grpc.NewServer(tenderService, bidService, reviewService, cfg.Server.GRPCToken, logger),
+		grpcserver.Addr(cfg.Server.GRPCAddr))
+	grpcServer.Start()
+	logger.Info("grpc server started", "addr", cfg.Server.GRPCAddr)
+
 	// graceful shutdown
 	select {
 	case <-ctx.Done():
+		// repeated signal terminates immediately
+		stop()
+
+		// readiness fails during delay, so load balancers stop routing traffic before shutdown
+		appHealth.Shutdown()
+		logger.Info("draining servers", "delay", cfg.Server.ShutdownDelay)
+		time.Sleep(cfg.Server.ShutdownDelay)
+
 		tctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		err = server.Stop(tctx)
 		if err != nil {
@@ -85,10 +212,28 @@ func Run() int {
 		} else {
 			logger.Info("http server has been stopped")
 		}
+		err = grpcServer.Stop(tctx)
+		if err != nil {
+			logger.Error("failed to stop grpc server", "err", err)
+		} else {
+			logger.Info("grpc server has been stopped")
+		}
+		err = adminServer.Stop(tctx)
+		if err != nil {
+			logger.Error("failed to stop admin http server", "err", err)
+		} else {
+			logger.Info("admin http server has been stopped")
+		}
 		cancel()
 		return 0
 	case err = <-server.Err():
 		logger.Error("http server returned error", "err", err)
 		return 1
+	case err = <-adminServer.Err():
+		logger.Error("admin http server returned error", "err", err)
+		return 1
+	case err = <-grpcServer.Err():
+		logger.Error("grpc server returned error", "err", err)
+		return 1
 	}
 }
@@ -1,8 +1,18 @@
 package app
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/service"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/ratelimit"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/tracing"
 )
 
 type EnvError struct {
@@ -13,10 +23,30 @@ func NewEnvError(env string) error { return &EnvError{env} }
 
 func (e *EnvError) Error() string { return fmt.Sprintf("could not find env variable: %s", e.env) }
 
+type EnvValueError struct {
+	env string
+	err error
+}
+
+func NewEnvValueError(env string, err error) error { return &EnvValueError{env, err} }
+
+func (e *EnvValueError) Error() string {
+	return fmt.Sprintf("invalid env variable: %s: %s", e.env, e.err.Error())
+}
+
+func (e *EnvValueError) Unwrap() error { return e.err }
+
 // Config.
 type Config struct {
-	Server   ConfigServer
-	Postgres ConfigPostgres
+	Server    ConfigServer
+	Postgres  ConfigPostgres
+	Bid       ConfigBid
+	Admin     ConfigAdmin
+	Watch     ConfigWatch
+	Tracing   ConfigTracing
+	RateLimit ConfigRateLimit
+	Tender    ConfigTender
+	Cache     ConfigCache
 }
 
 func (c *Config) ParseEnv() error {
@@ -24,16 +54,52 @@ func (c *Config) ParseEnv() error {
 		return err
 	}
 
-	return c.Postgres.ParseEnv()
+	if err := c.Postgres.ParseEnv(); err != nil {
+		return err
+	}
+
+	if err := c.Bid.ParseEnv(); err != nil {
+		return err
+	}
+
+	if err := c.Admin.ParseEnv(); err != nil {
+		return err
+	}
+
+	if err := c.Watch.ParseEnv(); err != nil {
+		return err
+	}
+
+	if err := c.Tracing.ParseEnv(); err != nil {
+		return err
+	}
+
+	if err := c.RateLimit.ParseEnv(); err != nil {
+		return err
+	}
+
+	if err := c.Tender.ParseEnv(); err != nil {
+		return err
+	}
+
+	return c.Cache.ParseEnv()
 }
 
 // ConfigServer.
 type ConfigServer struct {
-	Addr string
+	Addr          string
+	AdminAddr     string        // serves metrics and status, must not be exposed publicly
+	GRPCAddr      string        // serves tender, bid and review services
+	GRPCToken     string        // authenticates grpc clients if it is set
+	ShutdownDelay time.Duration // readiness fails during delay before shutdown, so traffic is drained
 }
 
 const (
-	EnvServerAddress = "SERVER_ADDRESS"
+	EnvServerAddress       = "SERVER_ADDRESS"
+	EnvServerAdminAddress  = "SERVER_ADMIN_ADDRESS"
+	EnvServerGRPCAddress   = "SERVER_GRPC_ADDRESS"
+	EnvServerGRPCToken     = "SERVER_GRPC_TOKEN"
+	EnvServerShutdownDelay = "SERVER_SHUTDOWN_DELAY"
 )
 
 func (c *ConfigServer) ParseEnv() error {
@@ -43,6 +109,31 @@ func (c *ConfigServer) ParseEnv() error {
 	}
 
 	c.Addr = addr
+
+	c.AdminAddr = "0.0.0.0:9090"
+	if adminAddr, ok := os.LookupEnv(EnvServerAdminAddress); ok {
+		c.AdminAddr = adminAddr
+	}
+
+	c.GRPCAddr = "0.0.0.0:9000"
+	if grpcAddr, ok := os.LookupEnv(EnvServerGRPCAddress); ok {
+		c.GRPCAddr = grpcAddr
+	}
+
+	c.GRPCToken = os.Getenv(EnvServerGRPCToken)
+
+	c.ShutdownDelay = 5 * time.Second
+	if delay, ok := os.LookupEnv(EnvServerShutdownDelay); ok {
+		d, err := time.ParseDuration(delay)
+		if err != nil {
+			return NewEnvValueError(EnvServerShutdownDelay, err)
+		}
+		if d < 0 {
+			return NewEnvValueError(EnvServerShutdownDelay, errors.New("must be >= 0"))
+		}
+		c.ShutdownDelay = d
+	}
+
 	return nil
 }
 
@@ -101,6 +192,263 @@ func (c *ConfigPostgres) ParseEnv() error {
 	return nil
 }
 
+// ConfigBid.
+type ConfigBid struct {
+	RejectRule service.BidRejectRule
+}
+
+const (
+	EnvBidRejectRule = "BID_REJECT_RULE"
+)
+
+func (c *ConfigBid) ParseEnv() error {
+	c.RejectRule = service.BidRejectFinal
+
+	rule, ok := os.LookupEnv(EnvBidRejectRule)
+	if !ok {
+		return nil
+	}
+
+	c.RejectRule = service.BidRejectRule(rule)
+	if err := c.RejectRule.Validate(); err != nil {
+		return NewEnvValueError(EnvBidRejectRule, err)
+	}
+	return nil
+}
+
+// ConfigAdmin.
+type ConfigAdmin struct {
+	Usernames []string
+}
+
+const (
+	EnvAdminUsernames = "ADMIN_USERNAMES"
+)
+
+func (c *ConfigAdmin) ParseEnv() error {
+	c.Usernames = nil
+	for _, username := range strings.Split(os.Getenv(EnvAdminUsernames), ",") {
+		if username = strings.TrimSpace(username); username != "" {
+			c.Usernames = append(c.Usernames, username)
+		}
+	}
+	return nil
+}
+
+// ConfigWatch.
+type ConfigWatch struct {
+	DeadlineWindow   time.Duration
+	DeadlineInterval time.Duration
+}
+
+const (
+	EnvWatchDeadlineWindow   = "WATCH_DEADLINE_WINDOW"
+	EnvWatchDeadlineInterval = "WATCH_DEADLINE_INTERVAL"
+)
+
+func (c *ConfigWatch) ParseEnv() error {
+	c.DeadlineWindow = 72 * time.Hour
+	c.DeadlineInterval = time.Hour
+
+	if window, ok := os.LookupEnv(EnvWatchDeadlineWindow); ok {
+		d, err := time.ParseDuration(window)
+		if err != nil {
+			return NewEnvValueError(EnvWatchDeadlineWindow, err)
+		}
+		c.DeadlineWindow = d
+	}
+
+	if interval, ok := os.LookupEnv(EnvWatchDeadlineInterval); ok {
+		d, err := time.ParseDuration(interval)
+		if err != nil {
+			return NewEnvValueError(EnvWatchDeadlineInterval, err)
+		}
+		if d <= 0 {
+			return NewEnvValueError(EnvWatchDeadlineInterval, errors.New("must be > 0"))
+		}
+		c.DeadlineInterval = d
+	}
+
+	return nil
+}
+
+// ConfigTracing.
+type ConfigTracing struct {
+	Exporter string
+}
+
+const (
+	EnvTracingExporter = "TRACING_EXPORTER"
+)
+
+func (c *ConfigTracing) ParseEnv() error {
+	c.Exporter = tracing.ExporterNone
+
+	exporter, ok := os.LookupEnv(EnvTracingExporter)
+	if !ok {
+		return nil
+	}
+
+	if !slices.Contains(tracing.Exporters, exporter) {
+		return NewEnvValueError(EnvTracingExporter, fmt.Errorf("must be one of: %v", tracing.Exporters))
+	}
+	c.Exporter = exporter
+	return nil
+}
+
+// ConfigRateLimit.
+type ConfigRateLimit struct {
+	Store  string
+	Limits ratelimit.Limits
+}
+
+const (
+	RateLimitStoreMemory   = "memory"
+	RateLimitStorePostgres = "postgres" // shared by replicas
+)
+
+const (
+	EnvRateLimitStore   = "RATE_LIMIT_STORE"
+	EnvRateLimitDefault = "RATE_LIMIT_DEFAULT"
+	EnvRateLimitRoutes  = "RATE_LIMIT_ROUTES"
+)
+
+// ParseEnv parses default limit and comma separated route limits, e.g. "POST /api/bids/new=10/1m".
+// Limit "0" is unlimited.
+func (c *ConfigRateLimit) ParseEnv() error {
+	c.Store = RateLimitStoreMemory
+	if store, ok := os.LookupEnv(EnvRateLimitStore); ok {
+		if store != RateLimitStoreMemory && store != RateLimitStorePostgres {
+			return NewEnvValueError(EnvRateLimitStore,
+				fmt.Errorf("must be one of: %s, %s", RateLimitStoreMemory, RateLimitStorePostgres))
+		}
+		c.Store = store
+	}
+
+	defaultLimit := cmp.Or(os.Getenv(EnvRateLimitDefault), "100/1s")
+	var err error
+	if c.Limits.Default, err = ratelimit.ParseLimit(defaultLimit); err != nil {
+		return NewEnvValueError(EnvRateLimitDefault, err)
+	}
+
+	routes, ok := os.LookupEnv(EnvRateLimitRoutes)
+	if !ok {
+		routes = "POST /api/tenders/new=60/1m,POST /api/bids/new=60/1m"
+	}
+	c.Limits.Routes = make(map[string]ratelimit.Limit)
+	for _, route := range strings.Split(routes, ",") {
+		if route = strings.TrimSpace(route); route == "" {
+			continue
+		}
+		pattern, limit, ok := strings.Cut(route, "=")
+		if !ok {
+			return NewEnvValueError(EnvRateLimitRoutes, errors.New("route limit must be formatted as <route>=<limit>"))
+		}
+		if c.Limits.Routes[strings.TrimSpace(pattern)], err = ratelimit.ParseLimit(limit); err != nil {
+			return NewEnvValueError(EnvRateLimitRoutes, err)
+		}
+	}
+
+	return nil
+}
+
+// ConfigTender.
+type ConfigTender struct {
+	MaxOpen int // max number of not closed tenders of organization, 0 is unlimited
+}
+
+const (
+	EnvTenderMaxOpen = "TENDER_MAX_OPEN"
+)
+
+func (c *ConfigTender) ParseEnv() error {
+	c.MaxOpen = 1000
+
+	maxOpen, ok := os.LookupEnv(EnvTenderMaxOpen)
+	if !ok {
+		return nil
+	}
+
+	n, err := strconv.Atoi(maxOpen)
+	if err != nil {
+		return NewEnvValueError(EnvTenderMaxOpen, err)
+	}
+	if n < 0 {
+		return NewEnvValueError(EnvTenderMaxOpen, errors.New("must be >= 0"))
+	}
+	c.MaxOpen = n
+	return nil
+}
+
+// ConfigCache.
+type ConfigCache struct {
+	Backend  string
+	TTL      time.Duration
+	Size     int    // max number of values cached by lru
+	RedisURL string // redis://<user>:<password>@<host>:<port>/<db>
+}
+
+const (
+	CacheBackendNone  = "none"
+	CacheBackendLRU   = "lru"
+	CacheBackendRedis = "redis" // shared by replicas
+)
+
+var CacheBackends = []string{CacheBackendNone, CacheBackendLRU, CacheBackendRedis}
+
+const (
+	EnvCacheBackend  = "CACHE_BACKEND"
+	EnvCacheTTL      = "CACHE_TTL"
+	EnvCacheSize     = "CACHE_SIZE"
+	EnvCacheRedisURL = "CACHE_REDIS_URL"
+)
+
+func (c *ConfigCache) ParseEnv() error {
+	c.Backend = CacheBackendLRU
+	c.TTL = 10 * time.Second
+	c.Size = 10000
+
+	if backend, ok := os.LookupEnv(EnvCacheBackend); ok {
+		if !slices.Contains(CacheBackends, backend) {
+			return NewEnvValueError(EnvCacheBackend, fmt.Errorf("must be one of: %v", CacheBackends))
+		}
+		c.Backend = backend
+	}
+
+	if ttl, ok := os.LookupEnv(EnvCacheTTL); ok {
+		d, err := time.ParseDuration(ttl)
+		if err != nil {
+			return NewEnvValueError(EnvCacheTTL, err)
+		}
+		if d <= 0 {
+			return NewEnvValueError(EnvCacheTTL, errors.New("must be > 0"))
+		}
+		c.TTL = d
+	}
+
+	if size, ok := os.LookupEnv(EnvCacheSize); ok {
+		n, err := strconv.Atoi(size)
+		if err != nil {
+			return NewEnvValueError(EnvCacheSize, err)
+		}
+		if n < 1 {
+			return NewEnvValueError(EnvCacheSize, errors.New("must be > 0"))
+		}
+		c.Size = n
+	}
+
+	if c.Backend != CacheBackendRedis {
+		return nil
+	}
+
+	redisURL, ok := os.LookupEnv(EnvCacheRedisURL)
+	if !ok {
+		return NewEnvError(EnvCacheRedisURL)
+	}
+	c.RedisURL = redisURL
+	return nil
+}
+
 // NewConfig.
 func NewConfig() (*Config, error) {
 	cfg := new(Config)
@@ -0,0 +1,27 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/service"
+)
+
+// CheckDeadlines periodically notifies subscribers about tenders nearing delivery date until ctx is done.
+func CheckDeadlines(ctx context.Context, cfg *Config, matcher service.TenderMatcher, logger *slog.Logger) {
+	ticker := time.NewTicker(cfg.Watch.DeadlineInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := matcher.CheckDeadlines(ctx, cfg.Watch.DeadlineWindow); err != nil {
+			logger.Error("failed to check tender deadlines", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
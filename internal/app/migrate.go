@@ -1,12 +1,17 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log/slog"
+	"os"
 
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/health"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/postgres"
 	"github.com/golang-migrate/migrate/v4"
-	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	migratepg "github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 )
 
@@ -40,3 +45,48 @@ func Migrate(cfg *Config, logger *slog.Logger) int {
 	logger.Info("migrations applied", "uri", cfg.Postgres.Conn)
 	return 0
 }
+
+// MigrationCheck returns readiness check, which fails until database is migrated to latest version.
+// Returns nil check if migrations path is not set.
+func MigrationCheck(cfg *Config, pg *postgres.Postgres) (health.CheckFunc, error) {
+	if cfg.Postgres.Migrations == "" {
+		return nil, nil
+	}
+
+	src, err := source.Open(fmt.Sprintf("file://%s", cfg.Postgres.Migrations))
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	expected, err := src.First()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		next, err := src.Next(expected)
+		if errors.Is(err, os.ErrNotExist) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		expected = next
+	}
+
+	query := fmt.Sprintf("SELECT version, dirty FROM %s LIMIT 1", migratepg.DefaultMigrationsTable)
+	return func(ctx context.Context) error {
+		var version int64
+		var dirty bool
+		if err := pg.Pool.QueryRow(ctx, query).Scan(&version, &dirty); err != nil {
+			return err
+		}
+		if dirty {
+			return fmt.Errorf("migration %d is dirty", version)
+		}
+		if uint(version) != expected {
+			return fmt.Errorf("migration version is %d, expected %d", version, expected)
+		}
+		return nil
+	}, nil
+}
@@ -66,6 +66,11 @@ type Bid struct {
 	CreatorID      uuid.UUID
 	Version        int
 	CreatedAt      time.Time
+	Round          int
+	Reason         *string
+	CustomFields   CustomFieldValues
+	UpdatedAt      time.Time // changed by updates of version too, e.g. status update
+	ContentVersion int       // version which content was last changed by, decisions are counted against it
 }
 
 func (b Bid) Validate() error {
@@ -83,19 +88,36 @@ func (b Bid) Validate() error {
 const (
 	BidNameLength              = 100
 	BidDescriptionLength       = 500
+	BidReasonLength            = 500
 	BidReviewDescriptionLength = 1000
 )
 
 var (
 	ErrBidName              = fmt.Errorf("bid name is too long (max %d)", BidNameLength)
 	ErrBidDescription       = fmt.Errorf("bid description is too long (max %d)", BidDescriptionLength)
+	ErrBidReasonEmpty       = fmt.Errorf("bid reason is empty")
+	ErrBidReason            = fmt.Errorf("bid reason is too long (max %d)", BidReasonLength)
 	ErrBidReviewDescription = fmt.Errorf("bid review description is too long (max %d)", BidReviewDescriptionLength)
 )
 
+// ValidateBidReason.
+func ValidateBidReason(reason string) error {
+	if len(reason) == 0 {
+		return ErrBidReasonEmpty
+	}
+
+	if len(reason) > BidReasonLength {
+		return ErrBidReason
+	}
+
+	return nil
+}
+
 // BidData.
 type BidData struct {
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
+	Name         *string           `json:"name"`
+	Description  *string           `json:"description"`
+	CustomFields CustomFieldValues `json:"customFields"` // merged with current values
 }
 
 func (d BidData) Validate() error {
@@ -127,6 +149,23 @@ func (r BidReview) Validate() error {
 	return nil
 }
 
+// Redacted returns review without its creator.
+func (r BidReview) Redacted() BidReview {
+	r.CreatorID = uuid.Nil
+	return r
+}
+
+// BidShortlist.
+type BidShortlist struct {
+	ID             uuid.UUID
+	BidID          uuid.UUID
+	TenderID       uuid.UUID
+	Round          int
+	OrganizationID *uuid.UUID // set to null when organization is deleted
+	CreatorID      uuid.UUID
+	CreatedAt      time.Time
+}
+
 // BidDecision.
 type BidDecision struct {
 	ID             uuid.UUID
@@ -135,4 +174,13 @@ type BidDecision struct {
 	OrganizationID uuid.UUID
 	CreatorID      uuid.UUID
 	CreatedAt      time.Time
+	ResetAt        *time.Time
+	BidVersion     int // content version of bid which decision is counted against
+	RevokedAt      *time.Time
+}
+
+// Redacted returns decision without its creator.
+func (d BidDecision) Redacted() BidDecision {
+	d.CreatorID = uuid.Nil
+	return d
 }
@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ServiceCategory.
+type ServiceCategory struct {
+	Name        TenderServiceType
+	Description string
+	ParentName  *TenderServiceType
+	CreatedAt   time.Time
+}
+
+func (c ServiceCategory) Validate() error {
+	if err := c.Name.Validate(); err != nil {
+		return err
+	}
+
+	if len(c.Description) > ServiceCategoryDescriptionLength {
+		return ErrServiceCategoryDescription
+	}
+
+	if c.ParentName != nil {
+		if *c.ParentName == c.Name {
+			return ErrServiceCategoryParent
+		}
+		return c.ParentName.Validate()
+	}
+
+	return nil
+}
+
+const (
+	ServiceCategoryNameLength        = 50
+	ServiceCategoryDescriptionLength = 500
+)
+
+var (
+	ErrServiceCategoryName = fmt.Errorf(
+		"service category name must not be empty and not too long (max %d)", ServiceCategoryNameLength,
+	)
+	ErrServiceCategoryDescription = fmt.Errorf(
+		"service category description is too long (max %d)", ServiceCategoryDescriptionLength,
+	)
+	ErrServiceCategoryParent = errors.New("service category cannot be parent of itself")
+)
+
+// ServiceCategoryData.
+type ServiceCategoryData struct {
+	Description *string            `json:"description"`
+	ParentName  *TenderServiceType `json:"parentName"`
+}
+
+func (d ServiceCategoryData) Validate() error {
+	if d.Description != nil && len(*d.Description) > ServiceCategoryDescriptionLength {
+		return ErrServiceCategoryDescription
+	}
+
+	// Empty parent name moves category to the root.
+	if d.ParentName != nil && *d.ParentName != "" {
+		return d.ParentName.Validate()
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CustomFieldType.
+type CustomFieldType string
+
+func (t CustomFieldType) Validate() error {
+	if !slices.Contains(CustomFieldTypes, t) {
+		return fmt.Errorf("custom field type must be one of: %v", CustomFieldTypes)
+	}
+	return nil
+}
+
+const (
+	CustomFieldString  CustomFieldType = "String"
+	CustomFieldNumber  CustomFieldType = "Number"
+	CustomFieldBoolean CustomFieldType = "Boolean"
+	CustomFieldDate    CustomFieldType = "Date" // formatted as 2006-01-02
+)
+
+var CustomFieldTypes = []CustomFieldType{CustomFieldString, CustomFieldNumber, CustomFieldBoolean, CustomFieldDate}
+
+// CustomFieldTarget.
+type CustomFieldTarget string
+
+func (t CustomFieldTarget) Validate() error {
+	if !slices.Contains(CustomFieldTargets, t) {
+		return fmt.Errorf("custom field target must be one of: %v", CustomFieldTargets)
+	}
+	return nil
+}
+
+const (
+	CustomFieldTender CustomFieldTarget = "Tender"
+	CustomFieldBid    CustomFieldTarget = "Bid"
+)
+
+var CustomFieldTargets = []CustomFieldTarget{CustomFieldTender, CustomFieldBid}
+
+// CustomField defines custom field of organization tenders or bids on them.
+type CustomField struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Target         CustomFieldTarget
+	Name           string
+	Type           CustomFieldType
+	Required       bool
+	AllowedValues  []any
+	CreatorID      uuid.UUID
+	CreatedAt      time.Time
+}
+
+func (f CustomField) Validate() error {
+	if f.Name == "" || len(f.Name) > CustomFieldNameLength {
+		return ErrCustomFieldName
+	}
+
+	if err := f.Target.Validate(); err != nil {
+		return err
+	}
+
+	if err := f.Type.Validate(); err != nil {
+		return err
+	}
+
+	if f.Type == CustomFieldBoolean && len(f.AllowedValues) > 0 {
+		return ErrCustomFieldAllowedValues
+	}
+
+	for _, value := range f.AllowedValues {
+		if err := f.validateType(value); err != nil {
+			return fmt.Errorf("allowed value %v: %w", value, err)
+		}
+	}
+
+	return nil
+}
+
+func (f CustomField) validateType(value any) error {
+	var ok bool
+	switch f.Type {
+	case CustomFieldString:
+		_, ok = value.(string)
+	case CustomFieldNumber:
+		_, ok = value.(float64)
+	case CustomFieldBoolean:
+		_, ok = value.(bool)
+	case CustomFieldDate:
+		var date string
+		if date, ok = value.(string); ok {
+			_, err := time.Parse(time.DateOnly, date)
+			ok = err == nil
+		}
+	}
+	if !ok {
+		return fmt.Errorf("value must be of type %s", f.Type)
+	}
+	return nil
+}
+
+// ValidateValue checks value type and that it is one of allowed values.
+func (f CustomField) ValidateValue(value any) error {
+	if err := f.validateType(value); err != nil {
+		return err
+	}
+
+	if len(f.AllowedValues) > 0 && !slices.Contains(f.AllowedValues, value) {
+		return fmt.Errorf("value must be one of: %v", f.AllowedValues)
+	}
+
+	return nil
+}
+
+const (
+	CustomFieldNameLength = 50
+)
+
+var (
+	ErrCustomFieldName = fmt.Errorf(
+		"custom field name must not be empty and not too long (max %d)", CustomFieldNameLength,
+	)
+	ErrCustomFieldAllowedValues = errors.New("boolean custom field cannot have allowed values")
+)
+
+// CustomFieldValues holds custom field values by field name.
+type CustomFieldValues map[string]any
+
+// Validate checks values against fields and that required fields are set.
+func (v CustomFieldValues) Validate(fields []CustomField) error {
+	if err := v.ValidateValues(fields); err != nil {
+		return err
+	}
+	return v.ValidateRequired(fields)
+}
+
+// ValidateValues checks each value is defined by field and matches it.
+func (v CustomFieldValues) ValidateValues(fields []CustomField) error {
+	for _, name := range slices.Sorted(maps.Keys(v)) {
+		i := slices.IndexFunc(fields, func(field CustomField) bool { return field.Name == name })
+		if i < 0 {
+			return fmt.Errorf("custom field %q is not defined", name)
+		}
+
+		// Null value unsets field.
+		if v[name] == nil {
+			continue
+		}
+
+		if err := fields[i].ValidateValue(v[name]); err != nil {
+			return fmt.Errorf("custom field %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// ValidateRequired checks required fields are set.
+func (v CustomFieldValues) ValidateRequired(fields []CustomField) error {
+	for _, field := range fields {
+		if field.Required && v[field.Name] == nil {
+			return fmt.Errorf("custom field %q is required", field.Name)
+		}
+	}
+	return nil
+}
+
+// Apply returns copy of values with data set. Null data value unsets field.
+func (v CustomFieldValues) Apply(data CustomFieldValues) CustomFieldValues {
+	values := maps.Clone(v)
+	if values == nil {
+		values = make(CustomFieldValues, len(data))
+	}
+	for name, value := range data {
+		if value == nil {
+			delete(values, name)
+		} else {
+			values[name] = value
+		}
+	}
+	return values
+}
+
+// Defined returns copy of values defined by fields.
+func (v CustomFieldValues) Defined(fields []CustomField) CustomFieldValues {
+	values := make(CustomFieldValues, len(v))
+	for _, field := range fields {
+		if value, ok := v[field.Name]; ok {
+			values[field.Name] = value
+		}
+	}
+	return values
+}
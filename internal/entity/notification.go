@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationEvent.
+type NotificationEvent string
+
+func (e NotificationEvent) Validate() error {
+	if !slices.Contains(NotificationEvents, e) {
+		return fmt.Errorf("notification event must be one of: %v", NotificationEvents)
+	}
+	return nil
+}
+
+const (
+	NotificationTenderPublished NotificationEvent = "TenderPublished"
+	NotificationTenderUpdated   NotificationEvent = "TenderUpdated"
+	NotificationTenderDeadline  NotificationEvent = "TenderDeadline"
+)
+
+var NotificationEvents = []NotificationEvent{
+	NotificationTenderPublished, NotificationTenderUpdated, NotificationTenderDeadline,
+}
+
+// Notification.
+type Notification struct {
+	ID            uuid.UUID
+	UserID        uuid.UUID
+	TenderID      uuid.UUID
+	TenderVersion int
+	Event         NotificationEvent
+	ReadAt        *time.Time
+	CreatedAt     time.Time
+}
@@ -0,0 +1,90 @@
+package entity
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TenderAnswerVisibility.
+type TenderAnswerVisibility string
+
+func (v TenderAnswerVisibility) Validate() error {
+	if !slices.Contains(TenderAnswerVisibilities, v) {
+		return fmt.Errorf("tender answer visibility must be one of: %v", TenderAnswerVisibilities)
+	}
+	return nil
+}
+
+const (
+	TenderAnswerPublic  TenderAnswerVisibility = "Public"
+	TenderAnswerPrivate TenderAnswerVisibility = "Private"
+)
+
+var TenderAnswerVisibilities = []TenderAnswerVisibility{TenderAnswerPublic, TenderAnswerPrivate}
+
+// TenderQuestion.
+type TenderQuestion struct {
+	ID          uuid.UUID
+	TenderID    uuid.UUID
+	Description string
+	CreatorID   uuid.UUID
+	CreatedAt   time.Time
+}
+
+func (q TenderQuestion) Validate() error {
+	if len(q.Description) == 0 {
+		return ErrTenderQuestionEmpty
+	}
+
+	if len(q.Description) > TenderQuestionDescriptionLength {
+		return ErrTenderQuestionDescription
+	}
+
+	return nil
+}
+
+// TenderAnswer.
+type TenderAnswer struct {
+	ID             uuid.UUID
+	QuestionID     uuid.UUID
+	Description    string
+	Visibility     TenderAnswerVisibility
+	OrganizationID *uuid.UUID // set to null when organization is deleted
+	CreatorID      uuid.UUID
+	CreatedAt      time.Time
+}
+
+func (a TenderAnswer) Validate() error {
+	if len(a.Description) == 0 {
+		return ErrTenderAnswerEmpty
+	}
+
+	if len(a.Description) > TenderAnswerDescriptionLength {
+		return ErrTenderAnswerDescription
+	}
+
+	return a.Visibility.Validate()
+}
+
+// TenderQuestionThread.
+type TenderQuestionThread struct {
+	Question TenderQuestion
+	Answers  []TenderAnswer
+}
+
+const (
+	TenderQuestionDescriptionLength = 1000
+	TenderAnswerDescriptionLength   = 1000
+)
+
+var (
+	ErrTenderQuestionEmpty       = fmt.Errorf("tender question description is empty")
+	ErrTenderQuestionDescription = fmt.Errorf(
+		"tender question description is too long (max %d)", TenderQuestionDescriptionLength)
+	ErrTenderAnswerEmpty       = fmt.Errorf("tender answer description is empty")
+	ErrTenderAnswerDescription = fmt.Errorf(
+		"tender answer description is too long (max %d)", TenderAnswerDescriptionLength)
+)
@@ -1,31 +1,26 @@
 package entity
 
 import (
+	"errors"
 	"fmt"
 	"slices"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/google/uuid"
 )
 
-// TenderServiceType.
+// TenderServiceType is name of service category.
 type TenderServiceType string
 
 func (t TenderServiceType) Validate() error {
-	if !slices.Contains(TenderServiceTypes, t) {
-		return fmt.Errorf("tender service type must be one of: %v", TenderServiceTypes)
+	if t == "" || len(t) > ServiceCategoryNameLength {
+		return ErrServiceCategoryName
 	}
 	return nil
 }
 
-const (
-	TenderConstruction TenderServiceType = "Construction"
-	TenderDelivery     TenderServiceType = "Delivery"
-	TenderManufacture  TenderServiceType = "Manufacture"
-)
-
-var TenderServiceTypes = []TenderServiceType{TenderConstruction, TenderDelivery, TenderManufacture}
-
 // TenderStatus.
 type TenderStatus string
 
@@ -44,6 +39,23 @@ const (
 
 var TenderStatuses = []TenderStatus{TenderCreated, TenderPublished, TenderClosed}
 
+// TenderVisibility.
+type TenderVisibility string
+
+func (v TenderVisibility) Validate() error {
+	if !slices.Contains(TenderVisibilities, v) {
+		return fmt.Errorf("tender visibility must be one of: %v", TenderVisibilities)
+	}
+	return nil
+}
+
+const (
+	TenderPublic     TenderVisibility = "Public"
+	TenderInviteOnly TenderVisibility = "InviteOnly"
+)
+
+var TenderVisibilities = []TenderVisibility{TenderPublic, TenderInviteOnly}
+
 // Tender.
 type Tender struct {
 	ID             uuid.UUID
@@ -55,6 +67,14 @@ type Tender struct {
 	CreatorID      uuid.UUID
 	Version        int
 	CreatedAt      time.Time
+	Round          int
+	Visibility     TenderVisibility
+	SourceID       *uuid.UUID // tender which this tender was cloned from
+	TemplateID     *uuid.UUID // template which this tender was created from
+	TenderTerms
+	CustomFields CustomFieldValues
+	Tags         []string
+	UpdatedAt    time.Time // changed by updates of version too, e.g. status update
 }
 
 func (t Tender) Validate() error {
@@ -70,6 +90,14 @@ func (t Tender) Validate() error {
 		return err
 	}
 
+	if err := t.Visibility.Validate(); err != nil {
+		return err
+	}
+
+	if err := t.TenderTerms.Validate(); err != nil {
+		return err
+	}
+
 	return t.Status.Validate()
 }
 
@@ -83,11 +111,131 @@ var (
 	ErrTenderDescription = fmt.Errorf("tender description is too long (max %d)", TenderDescriptionLength)
 )
 
+// TenderTerms holds budget and delivery terms of tender.
+type TenderTerms struct {
+	BudgetMin    *int64
+	BudgetMax    *int64
+	Currency     *string
+	Region       *string
+	Address      *string
+	DeliveryDate *time.Time
+}
+
+func (t TenderTerms) Validate() error {
+	if (t.BudgetMin != nil && *t.BudgetMin < 0) || (t.BudgetMax != nil && *t.BudgetMax < 0) {
+		return ErrTenderBudget
+	}
+
+	if t.BudgetMin != nil && t.BudgetMax != nil && *t.BudgetMin > *t.BudgetMax {
+		return ErrTenderBudgetRange
+	}
+
+	if t.Currency != nil {
+		if err := ValidateCurrency(*t.Currency); err != nil {
+			return err
+		}
+	} else if t.BudgetMin != nil || t.BudgetMax != nil {
+		return ErrTenderCurrencyEmpty
+	}
+
+	if t.Region != nil && len(*t.Region) > TenderRegionLength {
+		return ErrTenderRegion
+	}
+
+	if t.Address != nil && len(*t.Address) > TenderAddressLength {
+		return ErrTenderAddress
+	}
+
+	return nil
+}
+
+// ValidateCurrency checks currency is ISO 4217 code.
+func ValidateCurrency(currency string) error {
+	if len(currency) != 3 || strings.ContainsFunc(currency, func(r rune) bool { return r < 'A' || r > 'Z' }) {
+		return ErrTenderCurrency
+	}
+	return nil
+}
+
+const (
+	TenderRegionLength  = 100
+	TenderAddressLength = 500
+)
+
+var (
+	ErrTenderBudget        = errors.New("tender budget must be >= 0")
+	ErrTenderBudgetRange   = errors.New("tender budget min must be <= budget max")
+	ErrTenderCurrency      = errors.New("tender currency must be ISO 4217 code")
+	ErrTenderCurrencyEmpty = errors.New("tender currency must be set with budget")
+	ErrTenderRegion        = fmt.Errorf("tender region is too long (max %d)", TenderRegionLength)
+	ErrTenderAddress       = fmt.Errorf("tender address is too long (max %d)", TenderAddressLength)
+)
+
 // TenderData.
 type TenderData struct {
-	Name        *string            `json:"name"`
-	Description *string            `json:"description"`
-	ServiceType *TenderServiceType `json:"serviceType"`
+	Name         *string            `json:"name"`
+	Description  *string            `json:"description"`
+	ServiceType  *TenderServiceType `json:"serviceType"`
+	Visibility   *TenderVisibility  `json:"visibility"`
+	BudgetMin    *int64             `json:"budgetMin"`
+	BudgetMax    *int64             `json:"budgetMax"`
+	Currency     *string            `json:"currency"`
+	Region       *string            `json:"region"`
+	Address      *string            `json:"address"`
+	DeliveryDate *time.Time         `json:"deliveryDate"`
+	CustomFields CustomFieldValues  `json:"customFields"` // merged with current values
+	Tags         *[]string          `json:"tags"`         // replaces current tags
+}
+
+// Apply sets not nil data fields to tender.
+func (d TenderData) Apply(tender *Tender) {
+	if d.Name != nil {
+		tender.Name = *d.Name
+	}
+
+	if d.Description != nil {
+		tender.Description = *d.Description
+	}
+
+	if d.ServiceType != nil {
+		tender.ServiceType = *d.ServiceType
+	}
+
+	if d.Visibility != nil {
+		tender.Visibility = *d.Visibility
+	}
+
+	if d.BudgetMin != nil {
+		tender.BudgetMin = d.BudgetMin
+	}
+
+	if d.BudgetMax != nil {
+		tender.BudgetMax = d.BudgetMax
+	}
+
+	if d.Currency != nil {
+		tender.Currency = d.Currency
+	}
+
+	if d.Region != nil {
+		tender.Region = d.Region
+	}
+
+	if d.Address != nil {
+		tender.Address = d.Address
+	}
+
+	if d.DeliveryDate != nil {
+		tender.DeliveryDate = d.DeliveryDate
+	}
+
+	if d.CustomFields != nil {
+		tender.CustomFields = tender.CustomFields.Apply(d.CustomFields)
+	}
+
+	if d.Tags != nil {
+		tender.Tags = *d.Tags
+	}
 }
 
 func (d TenderData) Validate() error {
@@ -100,8 +248,174 @@ func (d TenderData) Validate() error {
 	}
 
 	if d.ServiceType != nil {
-		return d.ServiceType.Validate()
+		if err := d.ServiceType.Validate(); err != nil {
+			return err
+		}
 	}
 
+	if d.Visibility != nil {
+		if err := d.Visibility.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Budget range and currency presence are validated on tender with applied data.
+	if (d.BudgetMin != nil && *d.BudgetMin < 0) || (d.BudgetMax != nil && *d.BudgetMax < 0) {
+		return ErrTenderBudget
+	}
+
+	if d.Currency != nil {
+		if err := ValidateCurrency(*d.Currency); err != nil {
+			return err
+		}
+	}
+
+	if d.Region != nil && len(*d.Region) > TenderRegionLength {
+		return ErrTenderRegion
+	}
+
+	if d.Address != nil && len(*d.Address) > TenderAddressLength {
+		return ErrTenderAddress
+	}
+
+	return nil
+}
+
+// TenderFilter defines filters of public tender listing.
+type TenderFilter struct {
+	ServiceTypes []TenderServiceType // includes subcategories
+	BudgetMin    *int64              // tender budget range must overlap filter range
+	BudgetMax    *int64
+	Currency     *string
+	Regions      []string
+	Tags         []string
+	TagsMatchAll bool // tender must have all tags instead of any
+}
+
+func (f TenderFilter) Validate() error {
+	for _, serviceType := range f.ServiceTypes {
+		if err := serviceType.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if (f.BudgetMin != nil && *f.BudgetMin < 0) || (f.BudgetMax != nil && *f.BudgetMax < 0) {
+		return ErrTenderBudget
+	}
+
+	if f.BudgetMin != nil && f.BudgetMax != nil && *f.BudgetMin > *f.BudgetMax {
+		return ErrTenderBudgetRange
+	}
+
+	if f.Currency != nil {
+		return ValidateCurrency(*f.Currency)
+	}
+
+	return nil
+}
+
+// NormalizeTenderTags returns sorted unique tags in lower case with spaces replaced by dashes.
+func NormalizeTenderTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.Join(strings.Fields(strings.ToLower(tag)), "-")
+		if tag == "" || len(tag) > TenderTagLength {
+			return nil, ErrTenderTag
+		}
+		if strings.ContainsFunc(tag, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_'
+		}) {
+			return nil, ErrTenderTag
+		}
+		normalized = append(normalized, tag)
+	}
+
+	slices.Sort(normalized)
+	normalized = slices.Compact(normalized)
+	if len(normalized) > TenderTagsMax {
+		return nil, ErrTenderTags
+	}
+
+	return normalized, nil
+}
+
+const (
+	TenderTagLength = 50
+	TenderTagsMax   = 20
+)
+
+var (
+	ErrTenderTag = fmt.Errorf(
+		"tender tag must contain only letters, digits, dashes and underscores (max %d)", TenderTagLength,
+	)
+	ErrTenderTags = fmt.Errorf("tender has too many tags (max %d)", TenderTagsMax)
+)
+
+// TenderTag.
+type TenderTag struct {
+	Name  string
+	Count int
+}
+
+// TenderTemplate.
+type TenderTemplate struct {
+	ID             uuid.UUID
+	Name           string
+	Description    string
+	ServiceType    TenderServiceType
+	Visibility     TenderVisibility
+	OrganizationID uuid.UUID
+	CreatorID      uuid.UUID
+	CreatedAt      time.Time
+	TenderTerms
+	CustomFields CustomFieldValues
+	Tags         []string
+}
+
+// FromTender copies tender data into template.
+func (t *TenderTemplate) FromTender(tender *Tender) {
+	t.Name = tender.Name
+	t.Description = tender.Description
+	t.ServiceType = tender.ServiceType
+	t.Visibility = tender.Visibility
+	t.OrganizationID = tender.OrganizationID
+	t.TenderTerms = tender.TenderTerms
+	t.CustomFields = tender.CustomFields
+	t.Tags = tender.Tags
+}
+
+// ToTender returns new tender with template data.
+func (t TenderTemplate) ToTender() Tender {
+	templateID := t.ID
+	return Tender{
+		Name:           t.Name,
+		Description:    t.Description,
+		ServiceType:    t.ServiceType,
+		Status:         TenderCreated,
+		OrganizationID: t.OrganizationID,
+		Visibility:     t.Visibility,
+		TemplateID:     &templateID,
+		TenderTerms:    t.TenderTerms,
+		CustomFields:   t.CustomFields,
+		Tags:           t.Tags,
+	}
+}
+
+// TenderInvitation.
+type TenderInvitation struct {
+	ID             uuid.UUID
+	TenderID       uuid.UUID
+	OrganizationID *uuid.UUID
+	UserID         *uuid.UUID
+	CreatorID      uuid.UUID
+	CreatedAt      time.Time
+}
+
+var ErrTenderInvitationInvitee = errors.New("tender invitation must have either organization or user")
+
+func (i TenderInvitation) Validate() error {
+	if (i.OrganizationID == nil) == (i.UserID == nil) {
+		return ErrTenderInvitationInvitee
+	}
 	return nil
 }
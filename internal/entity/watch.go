@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TenderWatch subscribes user to changes of tender.
+type TenderWatch struct {
+	ID        uuid.UUID
+	TenderID  uuid.UUID
+	UserID    uuid.UUID
+	CreatedAt time.Time
+}
+
+// TenderSearch is saved search which subscribes user to matching public tenders.
+type TenderSearch struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	Name         string
+	ServiceType  *TenderServiceType // includes subcategories
+	Tags         []string
+	TagsMatchAll bool // tender must have all tags instead of any
+	CreatedAt    time.Time
+}
+
+func (s TenderSearch) Validate() error {
+	if s.Name == "" || len(s.Name) > TenderSearchNameLength {
+		return ErrTenderSearchName
+	}
+
+	if s.ServiceType == nil && len(s.Tags) == 0 {
+		return ErrTenderSearchEmpty
+	}
+
+	if s.ServiceType != nil {
+		return s.ServiceType.Validate()
+	}
+
+	return nil
+}
+
+const (
+	TenderSearchNameLength = 100
+)
+
+var (
+	ErrTenderSearchName  = fmt.Errorf("tender search name must not be empty (max %d)", TenderSearchNameLength)
+	ErrTenderSearchEmpty = errors.New("tender search must have service type or tags")
+)
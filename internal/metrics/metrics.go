@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// Metrics holds application collectors registered in own registry.
+type Metrics struct {
+	Registry *prometheus.Registry
+
+	RequestsTotal    *prometheus.CounterVec
+	RequestDuration  *prometheus.HistogramVec
+	RequestsInFlight prometheus.Gauge
+
+	TendersCreated prometheus.Counter
+	BidsSubmitted  prometheus.Counter
+	BidDecisions   *prometheus.CounterVec
+}
+
+func New(cs ...prometheus.Collector) *Metrics {
+	m := &Metrics{
+		Registry: prometheus.NewRegistry(),
+		RequestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "Number of handled HTTP requests.",
+		}, []string{"route", "code"}),
+		RequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "Duration of handled HTTP requests.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"route", "code"}),
+		RequestsInFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "http_requests_in_flight",
+			Help: "Number of HTTP requests being handled.",
+		}),
+		TendersCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "tenders_created_total",
+			Help: "Number of created tenders.",
+		}),
+		BidsSubmitted: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "bids_submitted_total",
+			Help: "Number of submitted bids.",
+		}),
+		BidDecisions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "bid_decisions_total",
+			Help: "Number of submitted bid decisions by type.",
+		}, []string{"decision"}),
+	}
+
+	m.Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.RequestsTotal,
+		m.RequestDuration,
+		m.RequestsInFlight,
+		m.TendersCreated,
+		m.BidsSubmitted,
+		m.BidDecisions,
+	)
+	m.Registry.MustRegister(cs...)
+
+	return m
+}
@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"context"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/service"
+	"github.com/google/uuid"
+)
+
+// tender counts tenders created by service.
+type tender struct {
+	service.Tender
+	metrics *Metrics
+}
+
+func NewTender(tenderService service.Tender, metrics *Metrics) service.Tender {
+	if tenderService == nil || metrics == nil {
+		return nil
+	}
+	return &tender{tenderService, metrics}
+}
+
+func (s *tender) count(tender *entity.Tender, err error) (*entity.Tender, error) {
+	if err == nil {
+		s.metrics.TendersCreated.Inc()
+	}
+	return tender, err
+}
+
+func (s *tender) Create(ctx context.Context, username string, tender entity.Tender) (*entity.Tender, error) {
+	return s.count(s.Tender.Create(ctx, username, tender))
+}
+
+func (s *tender) Clone(ctx context.Context, username string, tenderID uuid.UUID) (*entity.Tender, error) {
+	return s.count(s.Tender.Clone(ctx, username, tenderID))
+}
+
+func (s *tender) CreateFromTemplate(ctx context.Context,
+	username string, templateID uuid.UUID) (*entity.Tender, error) {
+	return s.count(s.Tender.CreateFromTemplate(ctx, username, templateID))
+}
+
+// bid counts bids and decisions submitted by service.
+type bid struct {
+	service.Bid
+	metrics *Metrics
+}
+
+func NewBid(bidService service.Bid, metrics *Metrics) service.Bid {
+	if bidService == nil || metrics == nil {
+		return nil
+	}
+	return &bid{bidService, metrics}
+}
+
+func (s *bid) Create(ctx context.Context, username string, bid entity.Bid) (*entity.Bid, error) {
+	createdBid, err := s.Bid.Create(ctx, username, bid)
+	if err == nil {
+		s.metrics.BidsSubmitted.Inc()
+	}
+	return createdBid, err
+}
+
+func (s *bid) SubmitDecision(ctx context.Context,
+	username string, bidID uuid.UUID, decision entity.BidStatus) (*entity.Bid, error) {
+	bid, err := s.Bid.SubmitDecision(ctx, username, bidID, decision)
+	if err == nil {
+		s.metrics.BidDecisions.WithLabelValues(string(decision)).Inc()
+	}
+	return bid, err
+}
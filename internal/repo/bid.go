@@ -13,19 +13,35 @@ type Bid interface {
 	Create(ctx context.Context, bid entity.Bid) (*entity.Bid, error)
 	GetByID(ctx context.Context, bidID uuid.UUID) (*entity.Bid, error)
 	GetByCreatorID(ctx context.Context, creatorID uuid.UUID, limit int, offset int) ([]entity.Bid, error)
-	GetByTenderID(ctx context.Context, tenderID uuid.UUID, limit int, offset int) ([]entity.Bid, error)
+	GetByTenderID(ctx context.Context, tenderID uuid.UUID, round *int, limit int, offset int) ([]entity.Bid, error)
 	Update(ctx context.Context, bidID uuid.UUID, data entity.BidData) (*entity.Bid, error)
 	UpdateStatus(ctx context.Context, bidID uuid.UUID, status entity.BidStatus) (*entity.Bid, error)
+	UpdateStatusWithReason(ctx context.Context,
+		bidID uuid.UUID, status entity.BidStatus, reason string) (*entity.Bid, error)
+	GetLastByStatus(ctx context.Context, bidID uuid.UUID, status entity.BidStatus) (*entity.Bid, error)
 	Rollback(ctx context.Context, bidID uuid.UUID, version int) (*entity.Bid, error)
 }
 
 type BidReview interface {
 	Create(ctx context.Context, review entity.BidReview) (*entity.BidReview, error)
 	GetByBidCreatorID(ctx context.Context, creatorID uuid.UUID, limit int, offset int) ([]entity.BidReview, error)
+	GetByBidID(ctx context.Context, bidID uuid.UUID, limit int, offset int) ([]entity.BidReview, error)
+	GetByBidAuthor(ctx context.Context,
+		userID uuid.UUID, tenderID *uuid.UUID, limit int, offset int) ([]entity.BidReview, error)
+}
+
+type BidShortlist interface {
+	Create(ctx context.Context, shortlist entity.BidShortlist) (*entity.BidShortlist, error)
+	GetByTenderID(ctx context.Context, tenderID uuid.UUID, round int) ([]entity.BidShortlist, error)
+	HasByAuthor(ctx context.Context,
+		tenderID uuid.UUID, round int, creatorID uuid.UUID, organizationID *uuid.UUID) error
 }
 
 type BidDecision interface {
 	Create(ctx context.Context, decision entity.BidDecision) (*entity.BidDecision, error)
-	GetByBidID(ctx context.Context,
-		bidID uuid.UUID, organizationID uuid.UUID, decisionType *entity.BidStatus) ([]entity.BidDecision, error)
+	GetByBidID(ctx context.Context, bidID uuid.UUID, bidVersion int,
+		organizationID uuid.UUID, decisionType *entity.BidStatus) ([]entity.BidDecision, error)
+	GetHistoryByBidID(ctx context.Context, bidID uuid.UUID, limit int, offset int) ([]entity.BidDecision, error)
+	Reset(ctx context.Context, bidID uuid.UUID) error
+	Revoke(ctx context.Context, bidID uuid.UUID, bidVersion int, creatorID uuid.UUID) (*entity.BidDecision, error)
 }
@@ -22,9 +22,13 @@ func NewBidPG(pg *postgres.Postgres) Bid {
 }
 
 func (r *bidPG) HasByCreatorID(ctx context.Context, creatorID uuid.UUID, tenderID uuid.UUID) error {
-	const query = `SELECT * FROM bid WHERE creator_id = $1 AND tender_id = $2`
+	const query = `SELECT id FROM
+		(SELECT DISTINCT ON (id) id, status 
+		FROM bid WHERE creator_id = $1 AND tender_id = $2 
+		ORDER BY id, version DESC) AS bid
+		WHERE status <> 'Canceled'`
 
-	rows, err := r.Pool.Query(ctx, query, creatorID, tenderID)
+	rows, err := r.Conn(ctx).Query(ctx, query, creatorID, tenderID)
 	if err != nil {
 		return err
 	}
@@ -41,11 +45,13 @@ func (r *bidPG) HasByCreatorID(ctx context.Context, creatorID uuid.UUID, tenderI
 }
 
 func (r *bidPG) Create(ctx context.Context, bid entity.Bid) (*entity.Bid, error) {
-	const query = `INSERT INTO bid (name, description, status, tender_id, organization_id, creator_id) 
-		VALUES ($1, $2, $3, $4, $5, $6) RETURNING *`
+	const query = `INSERT INTO bid 
+		(name, description, status, tender_id, organization_id, creator_id, round, custom_fields) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, COALESCE($8::JSONB, '{}')) RETURNING *`
 
-	rows, err := r.Pool.Query(ctx, query,
-		bid.Name, bid.Description, bid.Status, bid.TenderID, bid.OrganizationID, bid.CreatorID)
+	rows, err := r.Conn(ctx).Query(ctx, query,
+		bid.Name, bid.Description, bid.Status, bid.TenderID, bid.OrganizationID, bid.CreatorID, bid.Round,
+		bid.CustomFields)
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +62,7 @@ func (r *bidPG) Create(ctx context.Context, bid entity.Bid) (*entity.Bid, error)
 func (r *bidPG) GetByID(ctx context.Context, bidID uuid.UUID) (*entity.Bid, error) {
 	const query = `SELECT * FROM bid WHERE id = $1 ORDER BY version DESC`
 
-	rows, err := r.Pool.Query(ctx, query, bidID)
+	rows, err := r.Conn(ctx).Query(ctx, query, bidID)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +78,7 @@ func (r *bidPG) GetByCreatorID(ctx context.Context, creatorID uuid.UUID, limit i
 		LIMIT $2 OFFSET $3) AS bid
 		ORDER BY name ASC`
 
-	rows, err := r.Pool.Query(ctx, query, creatorID, limit, offset)
+	rows, err := r.Conn(ctx).Query(ctx, query, creatorID, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -80,15 +86,19 @@ func (r *bidPG) GetByCreatorID(ctx context.Context, creatorID uuid.UUID, limit i
 	return pgx.CollectRows(rows, pgx.RowToStructByPos[entity.Bid])
 }
 
-func (r *bidPG) GetByTenderID(ctx context.Context, tenderID uuid.UUID, limit int, offset int) ([]entity.Bid, error) {
+func (r *bidPG) GetByTenderID(ctx context.Context,
+	tenderID uuid.UUID, round *int, limit int, offset int) ([]entity.Bid, error) {
 	const query = `SELECT * FROM
+		(SELECT * FROM
 		(SELECT DISTINCT ON (id) * 
-		FROM bid WHERE tender_id = $1 AND status IN ('Published','Approved','Rejected') 
-		ORDER BY id, version DESC
-		LIMIT $2 OFFSET $3) AS bid
+		FROM bid WHERE tender_id = $1 AND ($2::int IS NULL OR round = $2) 
+		ORDER BY id, version DESC) AS bid
+		WHERE status IN ('Published','Approved','Rejected') OR (status = 'Canceled' AND reason IS NOT NULL)
+		ORDER BY id
+		LIMIT $3 OFFSET $4) AS bid
 		ORDER BY name ASC`
 
-	rows, err := r.Pool.Query(ctx, query, tenderID, limit, offset)
+	rows, err := r.Conn(ctx).Query(ctx, query, tenderID, round, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -97,7 +107,7 @@ func (r *bidPG) GetByTenderID(ctx context.Context, tenderID uuid.UUID, limit int
 }
 
 func (r *bidPG) Update(ctx context.Context, bidID uuid.UUID, data entity.BidData) (*entity.Bid, error) {
-	tx, err := r.Pool.Begin(ctx)
+	tx, err := r.Conn(ctx).Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -122,14 +132,21 @@ func (r *bidPG) Update(ctx context.Context, bidID uuid.UUID, data entity.BidData
 		bid.Description = *data.Description
 	}
 
+	if data.CustomFields != nil {
+		bid.CustomFields = bid.CustomFields.Apply(data.CustomFields)
+	}
+
 	bid.Version++
+	bid.ContentVersion = bid.Version
 
 	const insertQuery = `INSERT INTO bid 
-		(id, name, description, status, tender_id, organization_id, creator_id, version)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *`
+		(id, name, description, status, tender_id, organization_id, creator_id, version, round, reason,
+		custom_fields, content_version)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, COALESCE($11::JSONB, '{}'), $12) RETURNING *`
 
 	rows, err = tx.Query(ctx, insertQuery,
-		bid.ID, bid.Name, bid.Description, bid.Status, bid.TenderID, bid.OrganizationID, bid.CreatorID, bid.Version)
+		bid.ID, bid.Name, bid.Description, bid.Status, bid.TenderID, bid.OrganizationID, bid.CreatorID,
+		bid.Version, bid.Round, bid.Reason, bid.CustomFields, bid.ContentVersion)
 	if err != nil {
 		return nil, err
 	}
@@ -149,11 +166,30 @@ func (r *bidPG) Update(ctx context.Context, bidID uuid.UUID, data entity.BidData
 
 func (r *bidPG) UpdateStatus(ctx context.Context, bidID uuid.UUID, status entity.BidStatus) (*entity.Bid, error) {
 	const query = `UPDATE bid 
-	SET status = $2 
+	SET status = $2, reason = NULL, updated_at = CURRENT_TIMESTAMP 
 	WHERE id = $1 AND version = (SELECT MAX(version) FROM bid WHERE id = $1) 
 	RETURNING *`
 
-	rows, err := r.Pool.Query(ctx, query, bidID, status)
+	rows, err := r.Conn(ctx).Query(ctx, query, bidID, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.Bid](rows)
+}
+
+func (r *bidPG) UpdateStatusWithReason(ctx context.Context,
+	bidID uuid.UUID, status entity.BidStatus, reason string) (*entity.Bid, error) {
+	// Content is not changed, so content version is kept.
+	const query = `INSERT INTO bid 
+		(id, name, description, status, tender_id, organization_id, creator_id, version, round, reason,
+		custom_fields, content_version)
+		SELECT id, name, description, $2, tender_id, organization_id, creator_id, version + 1, round, $3,
+		custom_fields, content_version
+		FROM bid WHERE id = $1 ORDER BY version DESC LIMIT 1
+		RETURNING *`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, bidID, status, reason)
 	if err != nil {
 		return nil, err
 	}
@@ -161,8 +197,19 @@ func (r *bidPG) UpdateStatus(ctx context.Context, bidID uuid.UUID, status entity
 	return collectExactlyOneRow[entity.Bid](rows)
 }
 
+func (r *bidPG) GetLastByStatus(ctx context.Context, bidID uuid.UUID, status entity.BidStatus) (*entity.Bid, error) {
+	const query = `SELECT * FROM bid WHERE id = $1 AND status = $2 ORDER BY version DESC`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, bidID, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectOneRow[entity.Bid](rows)
+}
+
 func (r *bidPG) Rollback(ctx context.Context, bidID uuid.UUID, version int) (*entity.Bid, error) {
-	tx, err := r.Pool.Begin(ctx)
+	tx, err := r.Conn(ctx).Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -180,12 +227,15 @@ func (r *bidPG) Rollback(ctx context.Context, bidID uuid.UUID, version int) (*en
 	}
 
 	const insertQuery = `INSERT INTO bid
-		(id, name, description, status, tender_id, organization_id, creator_id, version)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, (SELECT MAX(version) FROM bid WHERE id = $1) + 1) 
+		(id, name, description, status, tender_id, organization_id, creator_id, version, round, reason,
+		custom_fields, content_version)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, (SELECT MAX(version) FROM bid WHERE id = $1) + 1, $8, $9, 
+		COALESCE($10::JSONB, '{}'), (SELECT MAX(version) FROM bid WHERE id = $1) + 1) 
 		RETURNING *`
 
 	rows, err = tx.Query(ctx, insertQuery,
-		bid.ID, bid.Name, bid.Description, bid.Status, bid.TenderID, bid.OrganizationID, bid.CreatorID)
+		bid.ID, bid.Name, bid.Description, bid.Status, bid.TenderID, bid.OrganizationID, bid.CreatorID,
+		bid.Round, bid.Reason, bid.CustomFields)
 	if err != nil {
 		return nil, err
 	}
@@ -219,7 +269,7 @@ func (r *bidReviewPG) Create(ctx context.Context, review entity.BidReview) (*ent
 	const query = `INSERT INTO bid_review (description, bid_id, organization_id, creator_id) 
 		VALUES ($1, $2, $3, $4) RETURNING *`
 
-	rows, err := r.Pool.Query(ctx, query, review.Description, review.BidID, review.OrganizationID, review.CreatorID)
+	rows, err := r.Conn(ctx).Query(ctx, query, review.Description, review.BidID, review.OrganizationID, review.CreatorID)
 	if err != nil {
 		return nil, err
 	}
@@ -236,7 +286,42 @@ func (r *bidReviewPG) GetByBidCreatorID(ctx context.Context,
 		JOIN bid_review ON bid.id = bid_review.bid_id
 		LIMIT $2 OFFSET $3`
 
-	rows, err := r.Pool.Query(ctx, query, creatorID, limit, offset)
+	rows, err := r.Conn(ctx).Query(ctx, query, creatorID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[entity.BidReview])
+}
+
+func (r *bidReviewPG) GetByBidID(ctx context.Context,
+	bidID uuid.UUID, limit int, offset int) ([]entity.BidReview, error) {
+	const query = `SELECT * FROM bid_review WHERE bid_id = $1 
+		ORDER BY created_at ASC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, bidID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[entity.BidReview])
+}
+
+func (r *bidReviewPG) GetByBidAuthor(ctx context.Context,
+	userID uuid.UUID, tenderID *uuid.UUID, limit int, offset int) ([]entity.BidReview, error) {
+	const query = `SELECT bid_review.id, description, bid_id, bid_review.organization_id, 
+		bid_review.creator_id, bid_review.created_at FROM
+		(SELECT DISTINCT ON (id) id, tender_id, organization_id, creator_id 
+		FROM bid ORDER BY id, version DESC) AS bid
+		JOIN bid_review ON bid.id = bid_review.bid_id
+		WHERE ($2::uuid IS NULL OR bid.tender_id = $2) AND 
+		((bid.organization_id IS NULL AND bid.creator_id = $1) OR 
+		bid.organization_id IN (SELECT organization_id FROM organization_responsible WHERE user_id = $1))
+		ORDER BY bid_review.created_at DESC
+		LIMIT $3 OFFSET $4`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, userID, tenderID, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -257,10 +342,11 @@ func NewBidDecisionPG(pg *postgres.Postgres) BidDecision {
 }
 
 func (r *bidDecisionPG) Create(ctx context.Context, decision entity.BidDecision) (*entity.BidDecision, error) {
-	const query = `INSERT INTO bid_decision (bid_id, type, organization_id, creator_id) 
-		VALUES ($1, $2, $3, $4) RETURNING *`
+	const query = `INSERT INTO bid_decision (bid_id, type, organization_id, creator_id, bid_version) 
+		VALUES ($1, $2, $3, $4, $5) RETURNING *`
 
-	rows, err := r.Pool.Query(ctx, query, decision.BidID, decision.Type, decision.OrganizationID, decision.CreatorID)
+	rows, err := r.Conn(ctx).Query(ctx, query,
+		decision.BidID, decision.Type, decision.OrganizationID, decision.CreatorID, decision.BidVersion)
 	if err != nil {
 		return nil, err
 	}
@@ -268,16 +354,117 @@ func (r *bidDecisionPG) Create(ctx context.Context, decision entity.BidDecision)
 	return collectExactlyOneRow[entity.BidDecision](rows)
 }
 
-func (r *bidDecisionPG) GetByBidID(ctx context.Context,
-	bidID uuid.UUID, organizationID uuid.UUID, decisionType *entity.BidStatus) ([]entity.BidDecision, error) {
-	const query = `SELECT DISTINCT ON (creator_id) * 
-		FROM bid_decision WHERE bid_id = $1 AND organization_id = $2 AND ($3::bid_decision_type IS NULL OR type = $3)
-		ORDER BY creator_id, created_at DESC`
+func (r *bidDecisionPG) GetByBidID(ctx context.Context, bidID uuid.UUID, bidVersion int,
+	organizationID uuid.UUID, decisionType *entity.BidStatus) ([]entity.BidDecision, error) {
+	const query = `SELECT * FROM
+		(SELECT DISTINCT ON (creator_id) * 
+		FROM bid_decision WHERE bid_id = $1 AND organization_id = $2 AND bid_version = $4 AND reset_at IS NULL
+		ORDER BY creator_id, created_at DESC) AS bid_decision
+		WHERE revoked_at IS NULL AND ($3::bid_decision_type IS NULL OR type = $3)`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, bidID, organizationID, decisionType, bidVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[entity.BidDecision])
+}
+
+func (r *bidDecisionPG) GetHistoryByBidID(ctx context.Context,
+	bidID uuid.UUID, limit int, offset int) ([]entity.BidDecision, error) {
+	const query = `SELECT * FROM bid_decision WHERE bid_id = $1 
+		ORDER BY created_at ASC
+		LIMIT $2 OFFSET $3`
 
-	rows, err := r.Pool.Query(ctx, query, bidID, organizationID, decisionType)
+	rows, err := r.Conn(ctx).Query(ctx, query, bidID, limit, offset)
 	if err != nil {
 		return nil, err
 	}
 
 	return pgx.CollectRows(rows, pgx.RowToStructByPos[entity.BidDecision])
 }
+
+func (r *bidDecisionPG) Reset(ctx context.Context, bidID uuid.UUID) error {
+	const query = `UPDATE bid_decision SET reset_at = CURRENT_TIMESTAMP WHERE bid_id = $1 AND reset_at IS NULL`
+
+	_, err := r.Conn(ctx).Exec(ctx, query, bidID)
+	return err
+}
+
+func (r *bidDecisionPG) Revoke(ctx context.Context,
+	bidID uuid.UUID, bidVersion int, creatorID uuid.UUID) (*entity.BidDecision, error) {
+	const query = `UPDATE bid_decision SET revoked_at = CURRENT_TIMESTAMP 
+		WHERE revoked_at IS NULL AND id = 
+		(SELECT id FROM bid_decision 
+		WHERE bid_id = $1 AND bid_version = $2 AND creator_id = $3 AND reset_at IS NULL
+		ORDER BY created_at DESC LIMIT 1)
+		RETURNING *`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, bidID, bidVersion, creatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.BidDecision](rows)
+}
+
+// bidShortlistPG.
+type bidShortlistPG struct {
+	*postgres.Postgres
+}
+
+func NewBidShortlistPG(pg *postgres.Postgres) BidShortlist {
+	if pg == nil {
+		return nil
+	}
+	return &bidShortlistPG{pg}
+}
+
+func (r *bidShortlistPG) Create(ctx context.Context, shortlist entity.BidShortlist) (*entity.BidShortlist, error) {
+	const query = `INSERT INTO bid_shortlist (bid_id, tender_id, round, organization_id, creator_id) 
+		VALUES ($1, $2, $3, $4, $5) 
+		ON CONFLICT (bid_id) DO UPDATE SET bid_id = EXCLUDED.bid_id
+		RETURNING *`
+
+	rows, err := r.Conn(ctx).Query(ctx, query,
+		shortlist.BidID, shortlist.TenderID, shortlist.Round, shortlist.OrganizationID, shortlist.CreatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.BidShortlist](rows)
+}
+
+func (r *bidShortlistPG) GetByTenderID(ctx context.Context,
+	tenderID uuid.UUID, round int) ([]entity.BidShortlist, error) {
+	const query = `SELECT * FROM bid_shortlist WHERE tender_id = $1 AND round = $2 ORDER BY created_at ASC`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, tenderID, round)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[entity.BidShortlist])
+}
+
+func (r *bidShortlistPG) HasByAuthor(ctx context.Context,
+	tenderID uuid.UUID, round int, creatorID uuid.UUID, organizationID *uuid.UUID) error {
+	const query = `SELECT s.id FROM bid_shortlist s JOIN bid b ON s.bid_id = b.id
+		WHERE s.tender_id = $1 AND s.round = $2 AND 
+		(($4::uuid IS NULL AND b.organization_id IS NULL AND b.creator_id = $3) OR b.organization_id = $4)`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, tenderID, round, creatorID, organizationID)
+	if err != nil {
+		return err
+	}
+
+	if !rows.Next() {
+		if rows.Err() == nil {
+			return ErrNoRows
+		}
+		return rows.Err()
+	}
+
+	rows.Close()
+	return rows.Err()
+}
@@ -0,0 +1,17 @@
+package repo
+
+import (
+	"context"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+)
+
+type ServiceCategory interface {
+	Create(ctx context.Context, category entity.ServiceCategory) (*entity.ServiceCategory, error)
+	GetByName(ctx context.Context, name entity.TenderServiceType) (*entity.ServiceCategory, error)
+	GetByNames(ctx context.Context, names []entity.TenderServiceType) ([]entity.ServiceCategory, error)
+	GetAll(ctx context.Context) ([]entity.ServiceCategory, error)
+	Update(ctx context.Context,
+		name entity.TenderServiceType, data entity.ServiceCategoryData) (*entity.ServiceCategory, error)
+	Delete(ctx context.Context, name entity.TenderServiceType) (*entity.ServiceCategory, error)
+}
@@ -0,0 +1,104 @@
+package repo
+
+import (
+	"context"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+type serviceCategoryPG struct {
+	*postgres.Postgres
+}
+
+func NewServiceCategoryPG(pg *postgres.Postgres) ServiceCategory {
+	if pg == nil {
+		return nil
+	}
+	return &serviceCategoryPG{pg}
+}
+
+func (r *serviceCategoryPG) Create(ctx context.Context,
+	category entity.ServiceCategory) (*entity.ServiceCategory, error) {
+	const query = `INSERT INTO service_category (name, description, parent_name) 
+		VALUES ($1, $2, $3) 
+		ON CONFLICT (name) DO NOTHING 
+		RETURNING *`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, category.Name, category.Description, category.ParentName)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.ServiceCategory](rows)
+}
+
+func (r *serviceCategoryPG) GetByName(ctx context.Context,
+	name entity.TenderServiceType) (*entity.ServiceCategory, error) {
+	const query = `SELECT * FROM service_category WHERE name = $1`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.ServiceCategory](rows)
+}
+
+func (r *serviceCategoryPG) GetByNames(ctx context.Context,
+	names []entity.TenderServiceType) ([]entity.ServiceCategory, error) {
+	const query = `SELECT * FROM service_category WHERE name = ANY($1::VARCHAR[]) ORDER BY name ASC`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, names)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[entity.ServiceCategory])
+}
+
+func (r *serviceCategoryPG) GetAll(ctx context.Context) ([]entity.ServiceCategory, error) {
+	const query = `SELECT * FROM service_category ORDER BY name ASC`
+
+	rows, err := r.Conn(ctx).Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[entity.ServiceCategory])
+}
+
+func (r *serviceCategoryPG) Update(ctx context.Context,
+	name entity.TenderServiceType, data entity.ServiceCategoryData) (*entity.ServiceCategory, error) {
+	const query = `UPDATE service_category 
+		SET description = COALESCE($2, description), 
+		parent_name = CASE WHEN $3::VARCHAR IS NULL THEN parent_name ELSE NULLIF($3, '') END
+		WHERE name = $1 
+		RETURNING *`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, name, data.Description, data.ParentName)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.ServiceCategory](rows)
+}
+
+func (r *serviceCategoryPG) Delete(ctx context.Context,
+	name entity.TenderServiceType) (*entity.ServiceCategory, error) {
+	const query = `DELETE FROM service_category 
+		WHERE name = $1 
+		AND NOT EXISTS (SELECT 1 FROM service_category WHERE parent_name = $1) 
+		AND NOT EXISTS (SELECT 1 FROM tender WHERE service_type = $1) 
+		AND NOT EXISTS (SELECT 1 FROM tender_template WHERE service_type = $1) 
+		AND NOT EXISTS (SELECT 1 FROM tender_search WHERE service_type = $1) 
+		RETURNING *`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.ServiceCategory](rows)
+}
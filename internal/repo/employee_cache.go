@@ -0,0 +1,65 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/cache"
+	"github.com/google/uuid"
+)
+
+// employeeCache caches employees and their organizations.
+// They are not written by application, so cached values only expire.
+type employeeCache struct {
+	Employee
+	cache cache.Cache
+	ttl   time.Duration
+}
+
+func NewEmployeeCache(employee Employee, c cache.Cache, ttl time.Duration) Employee {
+	if employee == nil || c == nil {
+		return nil
+	}
+	return &employeeCache{employee, c, ttl}
+}
+
+func (r *employeeCache) GetByID(ctx context.Context, employeeID uuid.UUID) (*entity.Employee, error) {
+	return loadCached(ctx, r.cache, "repo:employee:id:"+employeeID.String(), r.ttl,
+		func() (*entity.Employee, error) {
+			return r.Employee.GetByID(ctx, employeeID)
+		})
+}
+
+func (r *employeeCache) GetByUsername(ctx context.Context, username string) (*entity.Employee, error) {
+	return loadCached(ctx, r.cache, "repo:employee:username:"+username, r.ttl,
+		func() (*entity.Employee, error) {
+			return r.Employee.GetByUsername(ctx, username)
+		})
+}
+
+func (r *employeeCache) GetByOrganization(ctx context.Context, organizationID uuid.UUID) ([]entity.Employee, error) {
+	return loadCached(ctx, r.cache, "repo:employee:organization:"+organizationID.String(), r.ttl,
+		func() ([]entity.Employee, error) {
+			return r.Employee.GetByOrganization(ctx, organizationID)
+		})
+}
+
+func (r *employeeCache) HasOrganization(ctx context.Context, userID uuid.UUID, organizationID uuid.UUID) error {
+	has, err := loadCached(ctx, r.cache, "repo:employee:has:"+userID.String()+":"+organizationID.String(), r.ttl,
+		func() (bool, error) {
+			err := r.Employee.HasOrganization(ctx, userID, organizationID)
+			if errors.Is(err, ErrNoRows) {
+				return false, nil
+			}
+			return err == nil, err
+		})
+	if err != nil {
+		return err
+	}
+	if !has {
+		return ErrNoRows
+	}
+	return nil
+}
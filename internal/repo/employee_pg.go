@@ -23,7 +23,7 @@ func NewEmployeePG(pg *postgres.Postgres) Employee {
 func (r *employeePG) GetByID(ctx context.Context, employeeID uuid.UUID) (*entity.Employee, error) {
 	const query = `SELECT * FROM employee WHERE id = $1`
 
-	rows, err := r.Pool.Query(ctx, query, employeeID)
+	rows, err := r.Conn(ctx).Query(ctx, query, employeeID)
 	if err != nil {
 		return nil, err
 	}
@@ -34,7 +34,7 @@ func (r *employeePG) GetByID(ctx context.Context, employeeID uuid.UUID) (*entity
 func (r *employeePG) GetByUsername(ctx context.Context, username string) (*entity.Employee, error) {
 	const query = `SELECT * FROM employee WHERE username = $1`
 
-	rows, err := r.Pool.Query(ctx, query, username)
+	rows, err := r.Conn(ctx).Query(ctx, query, username)
 	if err != nil {
 		return nil, err
 	}
@@ -47,7 +47,7 @@ func (r *employeePG) GetByOrganization(ctx context.Context, organizationID uuid.
 		 FROM employee JOIN (SELECT user_id FROM organization_responsible WHERE organization_id = $1) AS r 
 		 ON employee.id = r.user_id`
 
-	rows, err := r.Pool.Query(ctx, query, organizationID)
+	rows, err := r.Conn(ctx).Query(ctx, query, organizationID)
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +58,7 @@ func (r *employeePG) GetByOrganization(ctx context.Context, organizationID uuid.
 func (r *employeePG) HasOrganization(ctx context.Context, userID uuid.UUID, organizationID uuid.UUID) error {
 	const query = `SELECT * FROM organization_responsible WHERE user_id = $1 AND organization_id = $2`
 
-	rows, err := r.Pool.Query(ctx, query, userID, organizationID)
+	rows, err := r.Conn(ctx).Query(ctx, query, userID, organizationID)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,15 @@
+package repo
+
+import (
+	"context"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"github.com/google/uuid"
+)
+
+type CustomField interface {
+	Create(ctx context.Context, field entity.CustomField) (*entity.CustomField, error)
+	GetByOrganizationID(ctx context.Context,
+		organizationID uuid.UUID, target *entity.CustomFieldTarget) ([]entity.CustomField, error)
+	Delete(ctx context.Context, organizationID uuid.UUID, fieldID uuid.UUID) (*entity.CustomField, error)
+}
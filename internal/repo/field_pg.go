@@ -0,0 +1,64 @@
+package repo
+
+import (
+	"context"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type customFieldPG struct {
+	*postgres.Postgres
+}
+
+func NewCustomFieldPG(pg *postgres.Postgres) CustomField {
+	if pg == nil {
+		return nil
+	}
+	return &customFieldPG{pg}
+}
+
+func (r *customFieldPG) Create(ctx context.Context, field entity.CustomField) (*entity.CustomField, error) {
+	const query = `INSERT INTO custom_field 
+		(organization_id, target, name, type, required, allowed_values, creator_id) 
+		VALUES ($1, $2, $3, $4, $5, COALESCE($6::JSONB, '[]'), $7) 
+		ON CONFLICT (organization_id, target, name) DO NOTHING 
+		RETURNING *`
+
+	rows, err := r.Conn(ctx).Query(ctx, query,
+		field.OrganizationID, field.Target, field.Name, field.Type, field.Required, field.AllowedValues,
+		field.CreatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.CustomField](rows)
+}
+
+func (r *customFieldPG) GetByOrganizationID(ctx context.Context,
+	organizationID uuid.UUID, target *entity.CustomFieldTarget) ([]entity.CustomField, error) {
+	const query = `SELECT * FROM custom_field 
+		WHERE organization_id = $1 AND ($2::custom_field_target IS NULL OR target = $2) 
+		ORDER BY target ASC, name ASC`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, organizationID, target)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[entity.CustomField])
+}
+
+func (r *customFieldPG) Delete(ctx context.Context,
+	organizationID uuid.UUID, fieldID uuid.UUID) (*entity.CustomField, error) {
+	const query = `DELETE FROM custom_field WHERE organization_id = $1 AND id = $2 RETURNING *`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, organizationID, fieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.CustomField](rows)
+}
@@ -0,0 +1,17 @@
+package repo
+
+import (
+	"context"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"github.com/google/uuid"
+)
+
+type Notification interface {
+	// CreateForUsers creates notification for each user, skipping repeated deadline notifications.
+	CreateForUsers(ctx context.Context,
+		notification entity.Notification, userIDs []uuid.UUID) ([]entity.Notification, error)
+	GetByUserID(ctx context.Context,
+		userID uuid.UUID, unread bool, limit int, offset int) ([]entity.Notification, error)
+	MarkRead(ctx context.Context, userID uuid.UUID, notificationID uuid.UUID) (*entity.Notification, error)
+}
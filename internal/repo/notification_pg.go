@@ -0,0 +1,83 @@
+package repo
+
+import (
+	"context"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type notificationPG struct {
+	*postgres.Postgres
+}
+
+func NewNotificationPG(pg *postgres.Postgres) Notification {
+	if pg == nil {
+		return nil
+	}
+	return &notificationPG{pg}
+}
+
+func (r *notificationPG) CreateForUsers(ctx context.Context,
+	notification entity.Notification, userIDs []uuid.UUID) ([]entity.Notification, error) {
+	// Insert in own (sub)transaction so failure does not abort transaction of tender change.
+	tx, err := r.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	const query = `INSERT INTO notification (user_id, tender_id, tender_version, event) 
+		SELECT user_id, $2, $3, $4 FROM unnest($1::UUID[]) AS user_id 
+		ON CONFLICT (user_id, tender_id) WHERE event = 'TenderDeadline' DO NOTHING 
+		RETURNING *`
+
+	rows, err := tx.Query(ctx, query, userIDs, notification.TenderID, notification.TenderVersion, notification.Event)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications, err := pgx.CollectRows(rows, pgx.RowToStructByPos[entity.Notification])
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (r *notificationPG) GetByUserID(ctx context.Context,
+	userID uuid.UUID, unread bool, limit int, offset int) ([]entity.Notification, error) {
+	const query = `SELECT * FROM notification 
+		WHERE user_id = $1 AND (NOT $2 OR read_at IS NULL) 
+		ORDER BY created_at DESC
+		LIMIT $3 OFFSET $4`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, userID, unread, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[entity.Notification])
+}
+
+func (r *notificationPG) MarkRead(ctx context.Context,
+	userID uuid.UUID, notificationID uuid.UUID) (*entity.Notification, error) {
+	const query = `UPDATE notification 
+		SET read_at = COALESCE(read_at, CURRENT_TIMESTAMP) 
+		WHERE user_id = $1 AND id = $2 
+		RETURNING *`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, userID, notificationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.Notification](rows)
+}
@@ -23,7 +23,7 @@ func NewOrganizationPG(pg *postgres.Postgres) Organization {
 func (r *organizationPG) GetByID(ctx context.Context, organizationID uuid.UUID) (*entity.Organization, error) {
 	const query = `SELECT * FROM organization WHERE id = $1`
 
-	rows, err := r.Pool.Query(ctx, query, organizationID)
+	rows, err := r.Conn(ctx).Query(ctx, query, organizationID)
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +36,7 @@ func (r *organizationPG) GetByEmployeeID(ctx context.Context, employeeID uuid.UU
 		FROM organization o JOIN organization_responsible r ON o.id = r.organization_id
 		WHERE r.user_id = $1`
 
-	rows, err := r.Pool.Query(ctx, query, employeeID)
+	rows, err := r.Conn(ctx).Query(ctx, query, employeeID)
 	if err != nil {
 		return nil, err
 	}
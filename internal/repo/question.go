@@ -0,0 +1,19 @@
+package repo
+
+import (
+	"context"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"github.com/google/uuid"
+)
+
+type TenderQuestion interface {
+	Create(ctx context.Context, question entity.TenderQuestion) (*entity.TenderQuestion, error)
+	GetByID(ctx context.Context, questionID uuid.UUID) (*entity.TenderQuestion, error)
+	GetByTenderID(ctx context.Context, tenderID uuid.UUID, limit int, offset int) ([]entity.TenderQuestion, error)
+}
+
+type TenderAnswer interface {
+	Create(ctx context.Context, answer entity.TenderAnswer) (*entity.TenderAnswer, error)
+	GetByQuestionIDs(ctx context.Context, questionIDs []uuid.UUID) ([]entity.TenderAnswer, error)
+}
@@ -0,0 +1,96 @@
+package repo
+
+import (
+	"context"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// tenderQuestionPG.
+type tenderQuestionPG struct {
+	*postgres.Postgres
+}
+
+func NewTenderQuestionPG(pg *postgres.Postgres) TenderQuestion {
+	if pg == nil {
+		return nil
+	}
+	return &tenderQuestionPG{pg}
+}
+
+func (r *tenderQuestionPG) Create(ctx context.Context, question entity.TenderQuestion) (*entity.TenderQuestion, error) {
+	const query = `INSERT INTO tender_question (tender_id, description, creator_id) 
+		VALUES ($1, $2, $3) RETURNING *`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, question.TenderID, question.Description, question.CreatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.TenderQuestion](rows)
+}
+
+func (r *tenderQuestionPG) GetByID(ctx context.Context, questionID uuid.UUID) (*entity.TenderQuestion, error) {
+	const query = `SELECT * FROM tender_question WHERE id = $1`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.TenderQuestion](rows)
+}
+
+func (r *tenderQuestionPG) GetByTenderID(ctx context.Context,
+	tenderID uuid.UUID, limit int, offset int) ([]entity.TenderQuestion, error) {
+	const query = `SELECT * FROM tender_question WHERE tender_id = $1 
+		ORDER BY created_at ASC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, tenderID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[entity.TenderQuestion])
+}
+
+// tenderAnswerPG.
+type tenderAnswerPG struct {
+	*postgres.Postgres
+}
+
+func NewTenderAnswerPG(pg *postgres.Postgres) TenderAnswer {
+	if pg == nil {
+		return nil
+	}
+	return &tenderAnswerPG{pg}
+}
+
+func (r *tenderAnswerPG) Create(ctx context.Context, answer entity.TenderAnswer) (*entity.TenderAnswer, error) {
+	const query = `INSERT INTO tender_answer (question_id, description, visibility, organization_id, creator_id) 
+		VALUES ($1, $2, $3, $4, $5) RETURNING *`
+
+	rows, err := r.Conn(ctx).Query(ctx, query,
+		answer.QuestionID, answer.Description, answer.Visibility, answer.OrganizationID, answer.CreatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.TenderAnswer](rows)
+}
+
+func (r *tenderAnswerPG) GetByQuestionIDs(ctx context.Context,
+	questionIDs []uuid.UUID) ([]entity.TenderAnswer, error) {
+	const query = `SELECT * FROM tender_answer WHERE question_id = ANY($1) ORDER BY created_at ASC`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, questionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[entity.TenderAnswer])
+}
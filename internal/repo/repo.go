@@ -1,8 +1,16 @@
 package repo
 
-import "errors"
+import (
+	"context"
+	"errors"
+)
 
 var (
 	ErrNoRows      = errors.New("no rows in result set")
 	ErrTooManyRows = errors.New("too many rows in result set")
 )
+
+// Transactor runs repository calls made with passed context in single transaction.
+type Transactor interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
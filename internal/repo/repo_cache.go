@@ -0,0 +1,27 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/cache"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/postgres"
+)
+
+// loadCached returns cached value or loads it by fn.
+// Values read in transaction may be not committed, so cache is bypassed.
+func loadCached[V any](ctx context.Context,
+	c cache.Cache, key string, ttl time.Duration, fn func() (V, error)) (V, error) {
+	if postgres.HasTx(ctx) {
+		return fn()
+	}
+	return cache.Load(ctx, c, key, ttl, fn)
+}
+
+// invalidateCached deletes cached values after transaction is committed, so they are not loaded again
+// before changes are visible.
+func invalidateCached(ctx context.Context, c cache.Cache, keys ...string) {
+	postgres.AfterCommit(ctx, func() {
+		cache.Invalidate(context.WithoutCancel(ctx), c, keys...)
+	})
+}
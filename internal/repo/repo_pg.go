@@ -3,9 +3,22 @@ package repo
 import (
 	"errors"
 
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/postgres"
 	"github.com/jackc/pgx/v5"
 )
 
+// transactorPG.
+type transactorPG struct {
+	*postgres.Postgres
+}
+
+func NewTransactorPG(pg *postgres.Postgres) Transactor {
+	if pg == nil {
+		return nil
+	}
+	return &transactorPG{pg}
+}
+
 func collectOneRow[T any](rows pgx.Rows) (*T, error) {
 	bid, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByPos[T])
 	if errors.Is(err, pgx.ErrNoRows) {
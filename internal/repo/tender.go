@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"time"
 
 	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
 	"github.com/google/uuid"
@@ -10,10 +11,35 @@ import (
 type Tender interface {
 	Create(ctx context.Context, tender entity.Tender) (*entity.Tender, error)
 	GetByID(ctx context.Context, tenderID uuid.UUID) (*entity.Tender, error)
-	GetByServiceType(ctx context.Context,
-		serviceTypes []entity.TenderServiceType, limit int, offset int) ([]entity.Tender, error)
+	GetByServiceType(ctx context.Context, filter entity.TenderFilter, limit int, offset int) ([]entity.Tender, error)
+	GetTags(ctx context.Context, limit int, offset int) ([]entity.TenderTag, error)
+	GetByDeliveryDate(ctx context.Context, before time.Time) ([]entity.Tender, error)
 	GetByCreatorID(ctx context.Context, creatorID uuid.UUID, limit int, offset int) ([]entity.Tender, error)
+	GetByInvitee(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]entity.Tender, error)
+	CountOpen(ctx context.Context, organizationID uuid.UUID) (int, error)
+	// LockOrganization serializes transactions, which lock same organization, until transaction ends.
+	LockOrganization(ctx context.Context, organizationID uuid.UUID) error
 	Update(ctx context.Context, tenderID uuid.UUID, data entity.TenderData) (*entity.Tender, error)
 	UpdateStatus(ctx context.Context, tenderID uuid.UUID, status entity.TenderStatus) (*entity.Tender, error)
+	UpdateRound(ctx context.Context, tenderID uuid.UUID, round int) (*entity.Tender, error)
 	Rollback(ctx context.Context, tenderID uuid.UUID, version int) (*entity.Tender, error)
 }
+
+type TenderInvitation interface {
+	HasByInvitee(ctx context.Context, tenderID uuid.UUID, userID *uuid.UUID, organizationID *uuid.UUID) error
+	// HasByUser checks user is invited directly or by organization which user is responsible for.
+	HasByUser(ctx context.Context, tenderID uuid.UUID, userID uuid.UUID) error
+
+	Create(ctx context.Context, invitation entity.TenderInvitation) (*entity.TenderInvitation, error)
+	GetByTenderID(ctx context.Context,
+		tenderID uuid.UUID, limit int, offset int) ([]entity.TenderInvitation, error)
+	Delete(ctx context.Context, tenderID uuid.UUID, invitationID uuid.UUID) (*entity.TenderInvitation, error)
+}
+
+type TenderTemplate interface {
+	Create(ctx context.Context, template entity.TenderTemplate) (*entity.TenderTemplate, error)
+	GetByID(ctx context.Context, templateID uuid.UUID) (*entity.TenderTemplate, error)
+	GetByOrganizationID(ctx context.Context,
+		organizationID uuid.UUID, limit int, offset int) ([]entity.TenderTemplate, error)
+	Delete(ctx context.Context, templateID uuid.UUID) (*entity.TenderTemplate, error)
+}
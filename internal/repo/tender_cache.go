@@ -0,0 +1,53 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/cache"
+	"github.com/google/uuid"
+)
+
+// tenderCache caches tenders by id, which are invalidated by updates.
+type tenderCache struct {
+	Tender
+	cache cache.Cache
+	ttl   time.Duration
+}
+
+func NewTenderCache(tender Tender, c cache.Cache, ttl time.Duration) Tender {
+	if tender == nil || c == nil {
+		return nil
+	}
+	return &tenderCache{tender, c, ttl}
+}
+
+func tenderCacheKey(tenderID uuid.UUID) string { return "repo:tender:id:" + tenderID.String() }
+
+func (r *tenderCache) GetByID(ctx context.Context, tenderID uuid.UUID) (*entity.Tender, error) {
+	return loadCached(ctx, r.cache, tenderCacheKey(tenderID), r.ttl, func() (*entity.Tender, error) {
+		return r.Tender.GetByID(ctx, tenderID)
+	})
+}
+
+func (r *tenderCache) Update(ctx context.Context, tenderID uuid.UUID, data entity.TenderData) (*entity.Tender, error) {
+	defer invalidateCached(ctx, r.cache, tenderCacheKey(tenderID))
+	return r.Tender.Update(ctx, tenderID, data)
+}
+
+func (r *tenderCache) UpdateStatus(ctx context.Context,
+	tenderID uuid.UUID, status entity.TenderStatus) (*entity.Tender, error) {
+	defer invalidateCached(ctx, r.cache, tenderCacheKey(tenderID))
+	return r.Tender.UpdateStatus(ctx, tenderID, status)
+}
+
+func (r *tenderCache) UpdateRound(ctx context.Context, tenderID uuid.UUID, round int) (*entity.Tender, error) {
+	defer invalidateCached(ctx, r.cache, tenderCacheKey(tenderID))
+	return r.Tender.UpdateRound(ctx, tenderID, round)
+}
+
+func (r *tenderCache) Rollback(ctx context.Context, tenderID uuid.UUID, version int) (*entity.Tender, error) {
+	defer invalidateCached(ctx, r.cache, tenderCacheKey(tenderID))
+	return r.Tender.Rollback(ctx, tenderID, version)
+}
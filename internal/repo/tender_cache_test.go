@@ -0,0 +1,261 @@
+package repo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/repo"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/cache"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const benchTenderLatency = time.Millisecond // simulated database round trip
+
+// tenderFake returns tenders after simulated latency.
+type tenderFake struct {
+	repo.Tender
+}
+
+func (tenderFake) GetByID(_ context.Context, tenderID uuid.UUID) (*entity.Tender, error) {
+	time.Sleep(benchTenderLatency)
+	return &entity.Tender{ID: tenderID, Name: "tender", Status: entity.TenderPublished, Version: 1, Round: 1}, nil
+}
+
+// BenchmarkTenderCacheGetByID compares concurrent gets of frequently requested tenders with and without cache.
+func BenchmarkTenderCacheGetByID(b *testing.B) {
+	tenderIDs := make([]uuid.UUID, 64)
+	for i := range tenderIDs {
+		tenderIDs[i] = uuid.New()
+	}
+
+	benchmarks := []struct {
+		name   string
+		tender repo.Tender
+	}{
+		{"uncached", tenderFake{}},
+		{"lru", repo.NewTenderCache(tenderFake{}, cache.NewLRU(1024), time.Minute)},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			ctx := context.Background()
+			b.RunParallel(func(pb *testing.PB) {
+				for i := 0; pb.Next(); i++ {
+					if _, err := bm.tender.GetByID(ctx, tenderIDs[i%len(tenderIDs)]); err != nil {
+						b.Error(err)
+						return
+					}
+				}
+			})
+		})
+	}
+}
+
+// tenderVersionFake counts gets of tenders, whose version is incremented by every write.
+type tenderVersionFake struct {
+	repo.Tender
+	gets    int
+	version int
+}
+
+func (r *tenderVersionFake) GetByID(_ context.Context, tenderID uuid.UUID) (*entity.Tender, error) {
+	r.gets++
+	return &entity.Tender{ID: tenderID, Status: entity.TenderPublished, Version: r.version, Round: 1}, nil
+}
+
+func (r *tenderVersionFake) write(tenderID uuid.UUID) (*entity.Tender, error) {
+	r.version++
+	return &entity.Tender{ID: tenderID, Status: entity.TenderPublished, Version: r.version, Round: 1}, nil
+}
+
+func (r *tenderVersionFake) Update(_ context.Context,
+	tenderID uuid.UUID, _ entity.TenderData) (*entity.Tender, error) {
+	return r.write(tenderID)
+}
+
+func (r *tenderVersionFake) UpdateStatus(_ context.Context,
+	tenderID uuid.UUID, _ entity.TenderStatus) (*entity.Tender, error) {
+	return r.write(tenderID)
+}
+
+func (r *tenderVersionFake) UpdateRound(_ context.Context, tenderID uuid.UUID, _ int) (*entity.Tender, error) {
+	return r.write(tenderID)
+}
+
+func (r *tenderVersionFake) Rollback(_ context.Context, tenderID uuid.UUID, _ int) (*entity.Tender, error) {
+	return r.write(tenderID)
+}
+
+// txFake is transaction, which only records whether it is committed.
+type txFake struct {
+	pgx.Tx
+	committed bool
+}
+
+func (tx *txFake) Commit(context.Context) error {
+	tx.committed = true
+	return nil
+}
+
+func (tx *txFake) Rollback(context.Context) error { return nil }
+
+var errTxAborted = errors.New("transaction aborted")
+
+var tenderCacheWrites = []struct {
+	name  string
+	write func(ctx context.Context, tender repo.Tender, tenderID uuid.UUID) error
+}{
+	{"Update", func(ctx context.Context, tender repo.Tender, tenderID uuid.UUID) error {
+		_, err := tender.Update(ctx, tenderID, entity.TenderData{})
+		return err
+	}},
+	{"UpdateStatus", func(ctx context.Context, tender repo.Tender, tenderID uuid.UUID) error {
+		_, err := tender.UpdateStatus(ctx, tenderID, entity.TenderClosed)
+		return err
+	}},
+	{"UpdateRound", func(ctx context.Context, tender repo.Tender, tenderID uuid.UUID) error {
+		_, err := tender.UpdateRound(ctx, tenderID, 2)
+		return err
+	}},
+	{"Rollback", func(ctx context.Context, tender repo.Tender, tenderID uuid.UUID) error {
+		_, err := tender.Rollback(ctx, tenderID, 1)
+		return err
+	}},
+}
+
+func newTenderCacheFake(t *testing.T) (repo.Tender, *tenderVersionFake, cache.Cache) {
+	t.Helper()
+	fake := &tenderVersionFake{version: 1}
+	c := cache.NewLRU(16)
+	return repo.NewTenderCache(fake, c, time.Minute), fake, c
+}
+
+func getTender(t *testing.T, ctx context.Context, tender repo.Tender, tenderID uuid.UUID) *entity.Tender {
+	t.Helper()
+	got, err := tender.GetByID(ctx, tenderID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	return got
+}
+
+func isTenderCached(t *testing.T, c cache.Cache, tenderID uuid.UUID) bool {
+	t.Helper()
+	_, ok, err := c.Get(context.Background(), "repo:tender:id:"+tenderID.String())
+	if err != nil {
+		t.Fatalf("cache.Get: %v", err)
+	}
+	return ok
+}
+
+func TestTenderCacheWriteInvalidates(t *testing.T) {
+	for _, tt := range tenderCacheWrites {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			tender, fake, c := newTenderCacheFake(t)
+			tenderID, otherID := uuid.New(), uuid.New()
+
+			getTender(t, ctx, tender, tenderID)
+			getTender(t, ctx, tender, otherID)
+			if got := getTender(t, ctx, tender, tenderID); got.Version != 1 || fake.gets != 2 {
+				t.Fatalf("tender is not cached: version %d, gets %d", got.Version, fake.gets)
+			}
+
+			if err := tt.write(ctx, tender, tenderID); err != nil {
+				t.Fatalf("%s: %v", tt.name, err)
+			}
+			if isTenderCached(t, c, tenderID) {
+				t.Fatalf("written tender is cached after %s", tt.name)
+			}
+			if !isTenderCached(t, c, otherID) {
+				t.Fatalf("other tender is not cached after %s", tt.name)
+			}
+			if got := getTender(t, ctx, tender, tenderID); got.Version != 2 || fake.gets != 3 {
+				t.Fatalf("stale tender is returned: version %d, gets %d", got.Version, fake.gets)
+			}
+		})
+	}
+}
+
+func TestTenderCacheBypassedInTx(t *testing.T) {
+	tests := []struct {
+		name   string
+		cached bool // tender is cached before transaction
+	}{
+		{"not cached", false},
+		{"cached", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			tender, fake, c := newTenderCacheFake(t)
+			tenderID := uuid.New()
+
+			gets := 0
+			if tt.cached {
+				getTender(t, ctx, tender, tenderID)
+				gets = 1
+			}
+
+			err := postgres.RunTx(ctx, &txFake{}, func(ctx context.Context) error {
+				getTender(t, ctx, tender, tenderID)
+				getTender(t, ctx, tender, tenderID)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("RunTx: %v", err)
+			}
+			if want := gets + 2; fake.gets != want {
+				t.Fatalf("gets in transaction are cached: gets %d, want %d", fake.gets, want)
+			}
+			if isTenderCached(t, c, tenderID) != tt.cached {
+				t.Fatalf("tender read in transaction is cached")
+			}
+		})
+	}
+}
+
+func TestTenderCacheInvalidatesAfterCommit(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error // returned by transaction
+		cached bool  // tender is cached after transaction
+	}{
+		{"committed", nil, false},
+		{"rolled back", errTxAborted, true},
+	}
+	for _, tt := range tests {
+		for _, w := range tenderCacheWrites {
+			t.Run(tt.name+" "+w.name, func(t *testing.T) {
+				ctx := context.Background()
+				tender, _, c := newTenderCacheFake(t)
+				tenderID := uuid.New()
+				getTender(t, ctx, tender, tenderID)
+
+				tx := &txFake{}
+				err := postgres.RunTx(ctx, tx, func(ctx context.Context) error {
+					if err := w.write(ctx, tender, tenderID); err != nil {
+						return err
+					}
+					if !isTenderCached(t, c, tenderID) {
+						t.Errorf("tender is invalidated before commit by %s", w.name)
+					}
+					return tt.err
+				})
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("RunTx: %v, want %v", err, tt.err)
+				}
+				if tx.committed != (tt.err == nil) {
+					t.Fatalf("transaction committed %t", tx.committed)
+				}
+				if isTenderCached(t, c, tenderID) != tt.cached {
+					t.Fatalf("tender cached %t after transaction, want %t", !tt.cached, tt.cached)
+				}
+			})
+		}
+	}
+}
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"time"
 
 	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
 	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/postgres"
@@ -21,11 +22,17 @@ func NewTenderPG(pg *postgres.Postgres) Tender {
 }
 
 func (r *tenderPG) Create(ctx context.Context, tender entity.Tender) (*entity.Tender, error) {
-	const query = `INSERT INTO tender (name, description, service_type, status, organization_id, creator_id) 
-		VALUES ($1, $2, $3, $4, $5, $6) RETURNING *`
-
-	rows, err := r.Pool.Query(ctx, query,
-		tender.Name, tender.Description, tender.ServiceType, tender.Status, tender.OrganizationID, tender.CreatorID)
+	const query = `INSERT INTO tender 
+		(name, description, service_type, status, organization_id, creator_id, visibility, source_id, template_id,
+		budget_min, budget_max, currency, region, address, delivery_date, custom_fields, tags) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, COALESCE($16::JSONB, '{}'), 
+		COALESCE($17::VARCHAR[], '{}')) RETURNING *`
+
+	rows, err := r.Conn(ctx).Query(ctx, query,
+		tender.Name, tender.Description, tender.ServiceType, tender.Status, tender.OrganizationID, tender.CreatorID,
+		tender.Visibility, tender.SourceID, tender.TemplateID,
+		tender.BudgetMin, tender.BudgetMax, tender.Currency, tender.Region, tender.Address, tender.DeliveryDate,
+		tender.CustomFields, tender.Tags)
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +43,7 @@ func (r *tenderPG) Create(ctx context.Context, tender entity.Tender) (*entity.Te
 func (r *tenderPG) GetByID(ctx context.Context, tenderID uuid.UUID) (*entity.Tender, error) {
 	const query = `SELECT * FROM tender WHERE id = $1 ORDER BY version DESC`
 
-	rows, err := r.Pool.Query(ctx, query, tenderID)
+	rows, err := r.Conn(ctx).Query(ctx, query, tenderID)
 	if err != nil {
 		return nil, err
 	}
@@ -45,16 +52,59 @@ func (r *tenderPG) GetByID(ctx context.Context, tenderID uuid.UUID) (*entity.Ten
 }
 
 func (r *tenderPG) GetByServiceType(ctx context.Context,
-	serviceTypes []entity.TenderServiceType, limit int, offset int) ([]entity.Tender, error) {
-	const query = `SELECT * FROM
-		(SELECT DISTINCT ON (id) * 
-		FROM tender 
-		WHERE (array_length($1::tender_service_type[], 1) IS NULL OR service_type = ANY($1)) AND status = 'Published' 
-		ORDER BY id, version DESC
-		LIMIT $2 OFFSET $3) AS tender
-		ORDER BY name ASC`
+	filter entity.TenderFilter, limit int, offset int) ([]entity.Tender, error) {
+	const query = `WITH RECURSIVE category AS 
+		(SELECT name FROM service_category WHERE name = ANY($1::VARCHAR[]) 
+		UNION 
+		SELECT service_category.name FROM service_category 
+		JOIN category ON service_category.parent_name = category.name)
+		SELECT * FROM tender 
+		WHERE version = (SELECT MAX(version) FROM tender AS latest WHERE latest.id = tender.id) 
+		AND (array_length($1::VARCHAR[], 1) IS NULL OR service_type IN (SELECT name FROM category)) 
+		AND status = 'Published' 
+		AND visibility = 'Public' 
+		AND ($4::BIGINT IS NULL OR COALESCE(budget_max, budget_min) >= $4) 
+		AND ($5::BIGINT IS NULL OR COALESCE(budget_min, budget_max) <= $5) 
+		AND ($6::VARCHAR IS NULL OR currency = $6) 
+		AND (array_length($7::VARCHAR[], 1) IS NULL OR region = ANY($7)) 
+		AND (array_length($8::VARCHAR[], 1) IS NULL OR (NOT $9 AND tags && $8) OR ($9 AND tags @> $8)) 
+		ORDER BY name ASC, id ASC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, filter.ServiceTypes, limit, offset,
+		filter.BudgetMin, filter.BudgetMax, filter.Currency, filter.Regions, filter.Tags, filter.TagsMatchAll)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[entity.Tender])
+}
+
+func (r *tenderPG) GetTags(ctx context.Context, limit int, offset int) ([]entity.TenderTag, error) {
+	const query = `SELECT tag, COUNT(*) FROM tender, unnest(tags) AS tag 
+		WHERE version = (SELECT MAX(version) FROM tender AS latest WHERE latest.id = tender.id) 
+		AND status = 'Published' 
+		AND visibility = 'Public' 
+		GROUP BY tag 
+		ORDER BY COUNT(*) DESC, tag ASC
+		LIMIT $1 OFFSET $2`
 
-	rows, err := r.Pool.Query(ctx, query, serviceTypes, limit, offset)
+	rows, err := r.Conn(ctx).Query(ctx, query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[entity.TenderTag])
+}
+
+func (r *tenderPG) GetByDeliveryDate(ctx context.Context, before time.Time) ([]entity.Tender, error) {
+	const query = `SELECT * FROM tender 
+		WHERE delivery_date BETWEEN CURRENT_DATE AND $1::DATE 
+		AND version = (SELECT MAX(version) FROM tender AS latest WHERE latest.id = tender.id) 
+		AND status = 'Published' 
+		ORDER BY delivery_date ASC, id ASC`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, before)
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +121,27 @@ func (r *tenderPG) GetByCreatorID(ctx context.Context,
 		LIMIT $2 OFFSET $3) AS tender
 		ORDER BY name ASC`
 
-	rows, err := r.Pool.Query(ctx, query, creatorID, limit, offset)
+	rows, err := r.Conn(ctx).Query(ctx, query, creatorID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[entity.Tender])
+}
+
+func (r *tenderPG) GetByInvitee(ctx context.Context,
+	userID uuid.UUID, limit int, offset int) ([]entity.Tender, error) {
+	const query = `SELECT * FROM
+		(SELECT DISTINCT ON (id) * 
+		FROM tender 
+		WHERE status = 'Published' AND visibility = 'InviteOnly' AND id IN 
+		(SELECT tender_id FROM tender_invitation WHERE user_id = $1 OR organization_id IN 
+		(SELECT organization_id FROM organization_responsible WHERE user_id = $1))
+		ORDER BY id, version DESC
+		LIMIT $2 OFFSET $3) AS tender
+		ORDER BY name ASC`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, userID, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -79,8 +149,26 @@ func (r *tenderPG) GetByCreatorID(ctx context.Context,
 	return pgx.CollectRows(rows, pgx.RowToStructByPos[entity.Tender])
 }
 
+func (r *tenderPG) CountOpen(ctx context.Context, organizationID uuid.UUID) (int, error) {
+	const query = `SELECT COUNT(*) FROM tender 
+		WHERE organization_id = $1 
+		AND version = (SELECT MAX(version) FROM tender AS latest WHERE latest.id = tender.id) 
+		AND status <> 'Closed'`
+
+	var count int
+	err := r.Conn(ctx).QueryRow(ctx, query, organizationID).Scan(&count)
+	return count, err
+}
+
+func (r *tenderPG) LockOrganization(ctx context.Context, organizationID uuid.UUID) error {
+	const query = `SELECT pg_advisory_xact_lock(hashtext($1::text))`
+
+	_, err := r.Conn(ctx).Exec(ctx, query, organizationID)
+	return err
+}
+
 func (r *tenderPG) Update(ctx context.Context, tenderID uuid.UUID, data entity.TenderData) (*entity.Tender, error) {
-	tx, err := r.Pool.Begin(ctx)
+	tx, err := r.Conn(ctx).Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -97,27 +185,22 @@ func (r *tenderPG) Update(ctx context.Context, tenderID uuid.UUID, data entity.T
 		return nil, err
 	}
 
-	if data.Name != nil {
-		tender.Name = *data.Name
-	}
-
-	if data.Description != nil {
-		tender.Description = *data.Description
-	}
-
-	if data.ServiceType != nil {
-		tender.ServiceType = *data.ServiceType
-	}
-
+	data.Apply(tender)
 	tender.Version++
 
 	const insertQuery = `INSERT INTO tender 
-		(id, name, description, service_type, status, organization_id, creator_id, version) 
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *`
+		(id, name, description, service_type, status, organization_id, creator_id, version, round, visibility,
+		source_id, template_id, budget_min, budget_max, currency, region, address, delivery_date, custom_fields,
+		tags) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, 
+		COALESCE($19::JSONB, '{}'), COALESCE($20::VARCHAR[], '{}')) RETURNING *`
 
 	rows, err = tx.Query(ctx, insertQuery,
 		tender.ID, tender.Name, tender.Description, tender.ServiceType,
-		tender.Status, tender.OrganizationID, tender.CreatorID, tender.Version)
+		tender.Status, tender.OrganizationID, tender.CreatorID, tender.Version, tender.Round, tender.Visibility,
+		tender.SourceID, tender.TemplateID,
+		tender.BudgetMin, tender.BudgetMax, tender.Currency, tender.Region, tender.Address, tender.DeliveryDate,
+		tender.CustomFields, tender.Tags)
 	if err != nil {
 		return nil, err
 	}
@@ -138,11 +221,25 @@ func (r *tenderPG) Update(ctx context.Context, tenderID uuid.UUID, data entity.T
 func (r *tenderPG) UpdateStatus(ctx context.Context,
 	tenderID uuid.UUID, status entity.TenderStatus) (*entity.Tender, error) {
 	const query = `UPDATE tender 
-		SET status = $2
+		SET status = $2, updated_at = CURRENT_TIMESTAMP
 		WHERE id = $1 AND version = (SELECT MAX(version) FROM tender WHERE id = $1)
 		RETURNING *`
 
-	rows, err := r.Pool.Query(ctx, query, tenderID, status)
+	rows, err := r.Conn(ctx).Query(ctx, query, tenderID, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.Tender](rows)
+}
+
+func (r *tenderPG) UpdateRound(ctx context.Context, tenderID uuid.UUID, round int) (*entity.Tender, error) {
+	const query = `UPDATE tender 
+		SET round = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND version = (SELECT MAX(version) FROM tender WHERE id = $1)
+		RETURNING *`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, tenderID, round)
 	if err != nil {
 		return nil, err
 	}
@@ -151,7 +248,7 @@ func (r *tenderPG) UpdateStatus(ctx context.Context,
 }
 
 func (r *tenderPG) Rollback(ctx context.Context, tenderID uuid.UUID, version int) (*entity.Tender, error) {
-	tx, err := r.Pool.Begin(ctx)
+	tx, err := r.Conn(ctx).Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -169,13 +266,20 @@ func (r *tenderPG) Rollback(ctx context.Context, tenderID uuid.UUID, version int
 	}
 
 	const insertQuery = `INSERT INTO tender 
-		(id, name, description, service_type, status, organization_id, creator_id, version) 
-		VALUES ($1, $2, $3, $4, $5, $6, $7, (SELECT MAX(version) FROM tender WHERE id = $1) + 1) 
+		(id, name, description, service_type, status, organization_id, creator_id, version, round, visibility,
+		source_id, template_id, budget_min, budget_max, currency, region, address, delivery_date, custom_fields,
+		tags) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, 
+		(SELECT MAX(version) FROM tender WHERE id = $1) + 1, 
+		(SELECT round FROM tender WHERE id = $1 ORDER BY version DESC LIMIT 1), $8, $9, $10, 
+		$11, $12, $13, $14, $15, $16, COALESCE($17::JSONB, '{}'), COALESCE($18::VARCHAR[], '{}')) 
 		RETURNING *`
 
 	rows, err = tx.Query(ctx, insertQuery,
 		tender.ID, tender.Name, tender.Description, tender.ServiceType, tender.Status,
-		tender.OrganizationID, tender.CreatorID)
+		tender.OrganizationID, tender.CreatorID, tender.Visibility, tender.SourceID, tender.TemplateID,
+		tender.BudgetMin, tender.BudgetMax, tender.Currency, tender.Region, tender.Address, tender.DeliveryDate,
+		tender.CustomFields, tender.Tags)
 	if err != nil {
 		return nil, err
 	}
@@ -192,3 +296,168 @@ func (r *tenderPG) Rollback(ctx context.Context, tenderID uuid.UUID, version int
 
 	return tender, nil
 }
+
+// tenderInvitationPG.
+type tenderInvitationPG struct {
+	*postgres.Postgres
+}
+
+func NewTenderInvitationPG(pg *postgres.Postgres) TenderInvitation {
+	if pg == nil {
+		return nil
+	}
+	return &tenderInvitationPG{pg}
+}
+
+func (r *tenderInvitationPG) HasByInvitee(ctx context.Context,
+	tenderID uuid.UUID, userID *uuid.UUID, organizationID *uuid.UUID) error {
+	const query = `SELECT id FROM tender_invitation 
+		WHERE tender_id = $1 AND (user_id = $2 OR organization_id = $3)`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, tenderID, userID, organizationID)
+	if err != nil {
+		return err
+	}
+
+	if !rows.Next() {
+		if rows.Err() == nil {
+			return ErrNoRows
+		}
+		return rows.Err()
+	}
+
+	rows.Close()
+	return rows.Err()
+}
+
+func (r *tenderInvitationPG) HasByUser(ctx context.Context, tenderID uuid.UUID, userID uuid.UUID) error {
+	const query = `SELECT id FROM tender_invitation 
+		WHERE tender_id = $1 AND (user_id = $2 OR organization_id IN 
+		(SELECT organization_id FROM organization_responsible WHERE user_id = $2))`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, tenderID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !rows.Next() {
+		if rows.Err() == nil {
+			return ErrNoRows
+		}
+		return rows.Err()
+	}
+
+	rows.Close()
+	return rows.Err()
+}
+
+func (r *tenderInvitationPG) Create(ctx context.Context,
+	invitation entity.TenderInvitation) (*entity.TenderInvitation, error) {
+	const query = `INSERT INTO tender_invitation (tender_id, organization_id, user_id, creator_id) 
+		SELECT $1, $2, $3, $4 
+		WHERE $2::uuid IS NULL OR EXISTS (SELECT id FROM organization WHERE id = $2)
+		ON CONFLICT DO NOTHING 
+		RETURNING *`
+
+	rows, err := r.Conn(ctx).Query(ctx, query,
+		invitation.TenderID, invitation.OrganizationID, invitation.UserID, invitation.CreatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.TenderInvitation](rows)
+}
+
+func (r *tenderInvitationPG) GetByTenderID(ctx context.Context,
+	tenderID uuid.UUID, limit int, offset int) ([]entity.TenderInvitation, error) {
+	const query = `SELECT * FROM tender_invitation WHERE tender_id = $1 
+		ORDER BY created_at ASC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, tenderID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[entity.TenderInvitation])
+}
+
+func (r *tenderInvitationPG) Delete(ctx context.Context,
+	tenderID uuid.UUID, invitationID uuid.UUID) (*entity.TenderInvitation, error) {
+	const query = `DELETE FROM tender_invitation WHERE tender_id = $1 AND id = $2 RETURNING *`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, tenderID, invitationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.TenderInvitation](rows)
+}
+
+// tenderTemplatePG.
+type tenderTemplatePG struct {
+	*postgres.Postgres
+}
+
+func NewTenderTemplatePG(pg *postgres.Postgres) TenderTemplate {
+	if pg == nil {
+		return nil
+	}
+	return &tenderTemplatePG{pg}
+}
+
+func (r *tenderTemplatePG) Create(ctx context.Context, template entity.TenderTemplate) (*entity.TenderTemplate, error) {
+	const query = `INSERT INTO tender_template 
+		(name, description, service_type, visibility, organization_id, creator_id,
+		budget_min, budget_max, currency, region, address, delivery_date, custom_fields, tags) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, COALESCE($13::JSONB, '{}'), 
+		COALESCE($14::VARCHAR[], '{}')) RETURNING *`
+
+	rows, err := r.Conn(ctx).Query(ctx, query,
+		template.Name, template.Description, template.ServiceType, template.Visibility,
+		template.OrganizationID, template.CreatorID,
+		template.BudgetMin, template.BudgetMax, template.Currency, template.Region, template.Address,
+		template.DeliveryDate, template.CustomFields, template.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.TenderTemplate](rows)
+}
+
+func (r *tenderTemplatePG) GetByID(ctx context.Context, templateID uuid.UUID) (*entity.TenderTemplate, error) {
+	const query = `SELECT * FROM tender_template WHERE id = $1`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.TenderTemplate](rows)
+}
+
+func (r *tenderTemplatePG) GetByOrganizationID(ctx context.Context,
+	organizationID uuid.UUID, limit int, offset int) ([]entity.TenderTemplate, error) {
+	const query = `SELECT * FROM tender_template 
+		WHERE organization_id = $1 
+		ORDER BY name ASC, created_at ASC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, organizationID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[entity.TenderTemplate])
+}
+
+func (r *tenderTemplatePG) Delete(ctx context.Context, templateID uuid.UUID) (*entity.TenderTemplate, error) {
+	const query = `DELETE FROM tender_template WHERE id = $1 RETURNING *`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.TenderTemplate](rows)
+}
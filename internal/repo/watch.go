@@ -0,0 +1,25 @@
+package repo
+
+import (
+	"context"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"github.com/google/uuid"
+)
+
+type TenderWatch interface {
+	Create(ctx context.Context, watch entity.TenderWatch) (*entity.TenderWatch, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]entity.TenderWatch, error)
+	GetUserIDsByTenderID(ctx context.Context, tenderID uuid.UUID) ([]uuid.UUID, error)
+	// GetInvitedUserIDsByTenderID returns users watching tender, who are invited directly or by organization
+	// or are responsible for tender organization.
+	GetInvitedUserIDsByTenderID(ctx context.Context, tenderID uuid.UUID, organizationID uuid.UUID) ([]uuid.UUID, error)
+	Delete(ctx context.Context, userID uuid.UUID, watchID uuid.UUID) (*entity.TenderWatch, error)
+}
+
+type TenderSearch interface {
+	Create(ctx context.Context, search entity.TenderSearch) (*entity.TenderSearch, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]entity.TenderSearch, error)
+	GetUserIDsByTender(ctx context.Context, tender entity.Tender) ([]uuid.UUID, error)
+	Delete(ctx context.Context, userID uuid.UUID, searchID uuid.UUID) (*entity.TenderSearch, error)
+}
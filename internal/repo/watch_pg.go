@@ -0,0 +1,158 @@
+package repo
+
+import (
+	"context"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type tenderWatchPG struct {
+	*postgres.Postgres
+}
+
+func NewTenderWatchPG(pg *postgres.Postgres) TenderWatch {
+	if pg == nil {
+		return nil
+	}
+	return &tenderWatchPG{pg}
+}
+
+func (r *tenderWatchPG) Create(ctx context.Context, watch entity.TenderWatch) (*entity.TenderWatch, error) {
+	const query = `INSERT INTO tender_watch (tender_id, user_id) 
+		VALUES ($1, $2) 
+		ON CONFLICT (tender_id, user_id) DO NOTHING 
+		RETURNING *`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, watch.TenderID, watch.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.TenderWatch](rows)
+}
+
+func (r *tenderWatchPG) GetByUserID(ctx context.Context,
+	userID uuid.UUID, limit int, offset int) ([]entity.TenderWatch, error) {
+	const query = `SELECT * FROM tender_watch WHERE user_id = $1 
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[entity.TenderWatch])
+}
+
+func (r *tenderWatchPG) GetUserIDsByTenderID(ctx context.Context, tenderID uuid.UUID) ([]uuid.UUID, error) {
+	const query = `SELECT user_id FROM tender_watch WHERE tender_id = $1`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, tenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+}
+
+func (r *tenderWatchPG) GetInvitedUserIDsByTenderID(ctx context.Context,
+	tenderID uuid.UUID, organizationID uuid.UUID) ([]uuid.UUID, error) {
+	const query = `SELECT user_id FROM tender_watch w WHERE tender_id = $1 AND (
+		EXISTS (SELECT id FROM tender_invitation i WHERE i.tender_id = w.tender_id AND (i.user_id = w.user_id OR 
+		i.organization_id IN (SELECT organization_id FROM organization_responsible WHERE user_id = w.user_id)))
+		OR EXISTS (SELECT id FROM organization_responsible WHERE organization_id = $2 AND user_id = w.user_id))`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, tenderID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+}
+
+func (r *tenderWatchPG) Delete(ctx context.Context, userID uuid.UUID, watchID uuid.UUID) (*entity.TenderWatch, error) {
+	const query = `DELETE FROM tender_watch WHERE user_id = $1 AND id = $2 RETURNING *`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, userID, watchID)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.TenderWatch](rows)
+}
+
+// tenderSearchPG.
+type tenderSearchPG struct {
+	*postgres.Postgres
+}
+
+func NewTenderSearchPG(pg *postgres.Postgres) TenderSearch {
+	if pg == nil {
+		return nil
+	}
+	return &tenderSearchPG{pg}
+}
+
+func (r *tenderSearchPG) Create(ctx context.Context, search entity.TenderSearch) (*entity.TenderSearch, error) {
+	const query = `INSERT INTO tender_search (user_id, name, service_type, tags, tags_match_all) 
+		VALUES ($1, $2, $3, COALESCE($4::VARCHAR[], '{}'), $5) 
+		RETURNING *`
+
+	rows, err := r.Conn(ctx).Query(ctx, query,
+		search.UserID, search.Name, search.ServiceType, search.Tags, search.TagsMatchAll)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.TenderSearch](rows)
+}
+
+func (r *tenderSearchPG) GetByUserID(ctx context.Context,
+	userID uuid.UUID, limit int, offset int) ([]entity.TenderSearch, error) {
+	const query = `SELECT * FROM tender_search WHERE user_id = $1 
+		ORDER BY name ASC, created_at ASC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[entity.TenderSearch])
+}
+
+func (r *tenderSearchPG) GetUserIDsByTender(ctx context.Context, tender entity.Tender) ([]uuid.UUID, error) {
+	const query = `WITH RECURSIVE category AS 
+		(SELECT name, parent_name FROM service_category WHERE name = $1 
+		UNION 
+		SELECT service_category.name, service_category.parent_name FROM service_category 
+		JOIN category ON service_category.name = category.parent_name)
+		SELECT DISTINCT user_id FROM tender_search 
+		WHERE (service_type IS NULL OR service_type IN (SELECT name FROM category)) 
+		AND (cardinality(tags) = 0 
+		OR (NOT tags_match_all AND tags && $2::VARCHAR[]) 
+		OR (tags_match_all AND tags <@ $2::VARCHAR[]))`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, tender.ServiceType, tender.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+}
+
+func (r *tenderSearchPG) Delete(ctx context.Context,
+	userID uuid.UUID, searchID uuid.UUID) (*entity.TenderSearch, error) {
+	const query = `DELETE FROM tender_search WHERE user_id = $1 AND id = $2 RETURNING *`
+
+	rows, err := r.Conn(ctx).Query(ctx, query, userID, searchID)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExactlyOneRow[entity.TenderSearch](rows)
+}
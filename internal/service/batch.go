@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"github.com/google/uuid"
+)
+
+const (
+	BatchSizeMax = 100
+)
+
+// BatchMode defines how batch is processed when some of its items fail.
+type BatchMode string
+
+func (m BatchMode) Validate() error {
+	if !slices.Contains(BatchModes, m) {
+		return fmt.Errorf("batch mode must be one of: %v", BatchModes)
+	}
+	return nil
+}
+
+const (
+	BatchAllOrNothing BatchMode = "AllOrNothing" // any failed item rolls back whole batch
+	BatchBestEffort   BatchMode = "BestEffort"   // each item is applied independently
+)
+
+var BatchModes = []BatchMode{BatchAllOrNothing, BatchBestEffort}
+
+var (
+	ErrBatchSize = NewTypedError(
+		fmt.Sprintf("batch must contain > 0 and <= %d items", BatchSizeMax), ErrorTypeInvalid, nil,
+	)
+	ErrBatchAborted = NewTypedError("batch aborted by failed item", ErrorTypeInvalid, nil)
+)
+
+type TenderStatusItem struct {
+	TenderID uuid.UUID
+	Status   entity.TenderStatus
+}
+
+type BidStatusItem struct {
+	BidID  uuid.UUID
+	Status entity.BidStatus
+}
+
+// BatchResult holds either updated value or error of batch item.
+type BatchResult[T any] struct {
+	Value *T
+	Err   error
+}
+
+type Batch interface {
+	UpdateTenderStatuses(ctx context.Context, username string,
+		items []TenderStatusItem, mode BatchMode) ([]BatchResult[entity.Tender], error)
+	UpdateBidStatuses(ctx context.Context, username string,
+		items []BidStatusItem, mode BatchMode) ([]BatchResult[entity.Bid], error)
+}
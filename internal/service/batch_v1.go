@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/repo"
+)
+
+type batchV1 struct {
+	transactor    repo.Transactor
+	tenderService Tender
+	bidService    Bid
+}
+
+func NewBatchV1(transactor repo.Transactor, tenderService Tender, bidService Bid) Batch {
+	if transactor == nil || tenderService == nil || bidService == nil {
+		return nil
+	}
+	return &batchV1{transactor, tenderService, bidService}
+}
+
+// errBatchItem stops transaction of all-or-nothing batch.
+var errBatchItem = errors.New("batch item failed")
+
+// runBatch applies fn to each item according to mode.
+func runBatch[I any, T any](ctx context.Context, transactor repo.Transactor,
+	items []I, mode BatchMode, fn func(ctx context.Context, item I) (*T, error)) ([]BatchResult[T], error) {
+	// Validate batch.
+	if err := mode.Validate(); err != nil {
+		return nil, NewTypedError("batch mode is invalid", ErrorTypeInvalid, err)
+	}
+	if len(items) == 0 || len(items) > BatchSizeMax {
+		return nil, ErrBatchSize
+	}
+
+	results := make([]BatchResult[T], len(items))
+
+	// Apply items independently.
+	if mode == BatchBestEffort {
+		for i, item := range items {
+			results[i].Value, results[i].Err = fn(ctx, item)
+		}
+		return results, nil
+	}
+
+	// Apply items in single transaction until first failure.
+	err := transactor.InTx(ctx, func(ctx context.Context) error {
+		for i, item := range items {
+			value, err := fn(ctx, item)
+			if err != nil {
+				results[i].Err = err
+				return errBatchItem
+			}
+			results[i].Value = value
+		}
+		return nil
+	})
+	if err == nil {
+		return results, nil
+	}
+	if !errors.Is(err, errBatchItem) {
+		return nil, NewTypedError("transactor.InTx", ErrorTypeInternal, err)
+	}
+
+	// Mark items that were rolled back or not processed.
+	for i := range results {
+		if results[i].Err == nil {
+			results[i] = BatchResult[T]{Err: ErrBatchAborted}
+		}
+	}
+
+	return results, nil
+}
+
+// UpdateTenderStatuses.
+func (s *batchV1) UpdateTenderStatuses(ctx context.Context, username string,
+	items []TenderStatusItem, mode BatchMode) ([]BatchResult[entity.Tender], error) {
+	ctx, span := tracer.Start(ctx, "batchV1.UpdateTenderStatuses")
+	defer span.End()
+
+	return runBatch(ctx, s.transactor, items, mode,
+		func(ctx context.Context, item TenderStatusItem) (*entity.Tender, error) {
+			return s.tenderService.UpdateStatus(ctx, username, item.TenderID, item.Status)
+		})
+}
+
+// UpdateBidStatuses.
+func (s *batchV1) UpdateBidStatuses(ctx context.Context, username string,
+	items []BidStatusItem, mode BatchMode) ([]BatchResult[entity.Bid], error) {
+	ctx, span := tracer.Start(ctx, "batchV1.UpdateBidStatuses")
+	defer span.End()
+
+	return runBatch(ctx, s.transactor, items, mode,
+		func(ctx context.Context, item BidStatusItem) (*entity.Bid, error) {
+			return s.bidService.UpdateStatus(ctx, username, item.BidID, item.Status)
+		})
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
 	"github.com/google/uuid"
@@ -24,20 +25,51 @@ var (
 	ErrBidCreator      = NewTypedError("user is not a creator", ErrorTypeForbidden, nil)
 	ErrBidNotPublished = NewTypedError("bid is not published", ErrorTypeInvalid, nil)
 	ErrBidCannotUpdate = NewTypedError("cannot update approved or rejected bid", ErrorTypeInvalid, nil)
+	ErrBidRound        = NewTypedError("bid round must be greater than 0", ErrorTypeInvalid, nil)
+	ErrBidNotInRound   = NewTypedError("bid is not in current tender round", ErrorTypeInvalid, nil)
+	ErrBidShortlist    = NewTypedError("bid author is not shortlisted in previous round", ErrorTypeForbidden, nil)
+	ErrBidNotCanceled  = NewTypedError("bid is not canceled", ErrorTypeInvalid, nil)
+
+	ErrBidDecisionNotExist = NewTypedError("bid decision does not exist", ErrorTypeNotExist, nil)
+)
+
+// BidRejectRule defines how rejected decisions affect bid status.
+type BidRejectRule string
+
+func (r BidRejectRule) Validate() error {
+	if !slices.Contains(BidRejectRules, r) {
+		return fmt.Errorf("bid reject rule must be one of: %v", BidRejectRules)
+	}
+	return nil
+}
+
+const (
+	BidRejectFinal  BidRejectRule = "final"  // single rejection rejects bid
+	BidRejectQuorum BidRejectRule = "quorum" // rejections are counted against quorum like approvals
 )
 
+var BidRejectRules = []BidRejectRule{BidRejectFinal, BidRejectQuorum}
+
 type Bid interface {
 	GetByID(ctx context.Context, bidID uuid.UUID) (*entity.Bid, error)
 	HasByCreatorAndTender(ctx context.Context, creatorID uuid.UUID, tenderID uuid.UUID) error
+	VerifyCreator(ctx context.Context, username string, bid *entity.Bid) error
 
 	Create(ctx context.Context, username string, bid entity.Bid) (*entity.Bid, error)
 	GetByCreatorUsername(ctx context.Context, username string, limit int, offset int) ([]entity.Bid, error)
-	GetByTenderID(ctx context.Context, username string, tenderID uuid.UUID, limit int, offset int) ([]entity.Bid, error)
-	GetStatus(ctx context.Context, username string, bidID uuid.UUID) (*entity.BidStatus, error)
+	GetByTenderID(ctx context.Context,
+		username string, tenderID uuid.UUID, round *int, limit int, offset int) ([]entity.Bid, error)
+	GetStatus(ctx context.Context, username string, bidID uuid.UUID) (*entity.Bid, error)
 	UpdateStatus(ctx context.Context, username string, bidID uuid.UUID, status entity.BidStatus) (*entity.Bid, error)
 	Update(ctx context.Context, username string, bidID uuid.UUID, data entity.BidData) (*entity.Bid, error)
 	SubmitDecision(ctx context.Context, username string, bidID uuid.UUID, decision entity.BidStatus) (*entity.Bid, error)
+	RevokeDecision(ctx context.Context, username string, bidID uuid.UUID) (*entity.Bid, error)
 	Rollback(ctx context.Context, username string, bidID uuid.UUID, version int) (*entity.Bid, error)
+	Shortlist(ctx context.Context, username string, bidID uuid.UUID) (*entity.Bid, error)
+	Withdraw(ctx context.Context, username string, bidID uuid.UUID, reason string) (*entity.Bid, error)
+	Resubmit(ctx context.Context, username string, bidID uuid.UUID, reason string) (*entity.Bid, error)
+	GetDecisions(ctx context.Context,
+		username string, bidID uuid.UUID, limit int, offset int) ([]entity.BidDecision, error)
 }
 
 const (
@@ -58,4 +90,7 @@ type BidReview interface {
 	GetByBidCreator(ctx context.Context,
 		requesterUsername string, creatorUsername string, tenderID uuid.UUID,
 		limit int, offset int) ([]entity.BidReview, error)
+	GetByBid(ctx context.Context, username string, bidID uuid.UUID, limit int, offset int) ([]entity.BidReview, error)
+	GetByAuthor(ctx context.Context,
+		username string, tenderID *uuid.UUID, limit int, offset int) ([]entity.BidReview, error)
 }
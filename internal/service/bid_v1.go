@@ -13,19 +13,34 @@ import (
 type bidV1 struct {
 	bidRepo         repo.Bid
 	decisionRepo    repo.BidDecision
+	shortlistRepo   repo.BidShortlist
 	tenderService   Tender
+	fieldService    CustomField
 	employeeService Employee
+	transactor      repo.Transactor
+	rejectRule      BidRejectRule
 }
 
-func NewBidV1(bidRepo repo.Bid, decisionRepo repo.BidDecision, tenderService Tender, employeeService Employee) Bid {
-	if bidRepo == nil || tenderService == nil || employeeService == nil {
+func NewBidV1(bidRepo repo.Bid, decisionRepo repo.BidDecision, shortlistRepo repo.BidShortlist,
+	tenderService Tender, fieldService CustomField, employeeService Employee, transactor repo.Transactor,
+	rejectRule BidRejectRule) Bid {
+	if bidRepo == nil || shortlistRepo == nil || tenderService == nil || fieldService == nil ||
+		employeeService == nil || transactor == nil {
 		return nil
 	}
-	return &bidV1{bidRepo, decisionRepo, tenderService, employeeService}
+	if rejectRule.Validate() != nil {
+		return nil
+	}
+	return &bidV1{
+		bidRepo, decisionRepo, shortlistRepo, tenderService, fieldService, employeeService, transactor, rejectRule,
+	}
 }
 
 // GetByID.
 func (s *bidV1) GetByID(ctx context.Context, bidID uuid.UUID) (*entity.Bid, error) {
+	ctx, span := tracer.Start(ctx, "bidV1.GetByID")
+	defer span.End()
+
 	bid, err := s.bidRepo.GetByID(ctx, bidID)
 	if err != nil {
 		if errors.Is(err, repo.ErrNoRows) {
@@ -38,6 +53,9 @@ func (s *bidV1) GetByID(ctx context.Context, bidID uuid.UUID) (*entity.Bid, erro
 
 // HasByCreatorAndTender.
 func (s *bidV1) HasByCreatorAndTender(ctx context.Context, creatorID uuid.UUID, tenderID uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "bidV1.HasByCreatorAndTender")
+	defer span.End()
+
 	err := s.bidRepo.HasByCreatorID(ctx, creatorID, tenderID)
 	if err != nil {
 		if errors.Is(err, repo.ErrNoRows) {
@@ -49,23 +67,39 @@ func (s *bidV1) HasByCreatorAndTender(ctx context.Context, creatorID uuid.UUID,
 	return nil
 }
 
+// VerifyCreator.
+func (s *bidV1) VerifyCreator(ctx context.Context, username string, bid *entity.Bid) error {
+	ctx, span := tracer.Start(ctx, "bidV1.VerifyCreator")
+	defer span.End()
+
+	// Verify employee ot private user.
+	if bid.OrganizationID != nil {
+		_, err := s.employeeService.GetEmployee(ctx, username, *bid.OrganizationID)
+		return err
+	}
+
+	user, err := s.employeeService.GetUser(ctx, username)
+	if err != nil {
+		return err
+	}
+	if user.ID != bid.CreatorID {
+		return ErrBidCreator
+	}
+
+	return nil
+}
+
 // Create.
 func (s *bidV1) Create(ctx context.Context, username string, bid entity.Bid) (*entity.Bid, error) {
+	ctx, span := tracer.Start(ctx, "bidV1.Create")
+	defer span.End()
+
 	// Validate data to create bid.
 	err := bid.Validate()
 	if err != nil {
 		return nil, NewTypedError("bid data is invalid", ErrorTypeInvalid, err)
 	}
 
-	// Verify tender status.
-	status, err := s.tenderService.GetStatus(ctx, username, bid.TenderID)
-	if err != nil {
-		return nil, err
-	}
-	if status == nil || *status != entity.TenderPublished {
-		return nil, ErrTenderNotPublished
-	}
-
 	// Set bid employee or private user.
 	if bid.OrganizationID != nil {
 		employee, err := s.employeeService.GetEmployee(ctx, username, *bid.OrganizationID)
@@ -81,8 +115,48 @@ func (s *bidV1) Create(ctx context.Context, username string, bid entity.Bid) (*e
 		bid.CreatorID = user.ID
 	}
 
-	// Set bid version.
+	// Get tender by id.
+	tender, err := s.tenderService.GetByID(ctx, bid.TenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify tender status.
+	if tender.Status != entity.TenderPublished {
+		return nil, ErrTenderNotPublished
+	}
+
+	// Verify bid author is invited to invite-only tender.
+	if tender.Visibility == entity.TenderInviteOnly {
+		err = s.tenderService.HasInvitation(ctx, tender.ID, bid.CreatorID, bid.OrganizationID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Verify bid author is shortlisted in previous round.
+	if tender.Round > 1 {
+		err = s.shortlistRepo.HasByAuthor(ctx, tender.ID, tender.Round-1, bid.CreatorID, bid.OrganizationID)
+		if err != nil {
+			if errors.Is(err, repo.ErrNoRows) {
+				return nil, ErrBidShortlist
+			}
+			return nil, NewTypedError("shortlistRepo.HasByAuthor", ErrorTypeInternal, err)
+		}
+	}
+
+	// Validate custom fields against schema of tender organization.
+	fields, err := s.fieldService.GetSchema(ctx, tender.OrganizationID, entity.CustomFieldBid)
+	if err != nil {
+		return nil, err
+	}
+	if err = bid.CustomFields.Validate(fields); err != nil {
+		return nil, NewTypedError("bid custom fields are invalid", ErrorTypeInvalid, err)
+	}
+
+	// Set bid version and round.
 	bid.Version = 1
+	bid.Round = tender.Round
 
 	// Create bid.
 	createdBid, err := s.bidRepo.Create(ctx, bid)
@@ -108,6 +182,9 @@ func (s *bidV1) getLimit(limit int) (int, error) {
 // GetByCreatorUsername.
 func (s *bidV1) GetByCreatorUsername(ctx context.Context,
 	username string, limit int, offset int) ([]entity.Bid, error) {
+	ctx, span := tracer.Start(ctx, "bidV1.GetByCreatorUsername")
+	defer span.End()
+
 	// Validate limit.
 	limit, err := s.getLimit(limit)
 	if err != nil {
@@ -136,7 +213,10 @@ func (s *bidV1) GetByCreatorUsername(ctx context.Context,
 
 // GetByTenderID.
 func (s *bidV1) GetByTenderID(ctx context.Context,
-	username string, tenderID uuid.UUID, limit int, offset int) ([]entity.Bid, error) {
+	username string, tenderID uuid.UUID, round *int, limit int, offset int) ([]entity.Bid, error) {
+	ctx, span := tracer.Start(ctx, "bidV1.GetByTenderID")
+	defer span.End()
+
 	// Validate limit.
 	limit, err := s.getLimit(limit)
 	if err != nil {
@@ -148,6 +228,11 @@ func (s *bidV1) GetByTenderID(ctx context.Context,
 		return nil, ErrBidOffset
 	}
 
+	// Validate round.
+	if round != nil && *round < 1 {
+		return nil, ErrBidRound
+	}
+
 	// Get tender by id.
 	tender, err := s.tenderService.GetByID(ctx, tenderID)
 	if err != nil {
@@ -161,7 +246,7 @@ func (s *bidV1) GetByTenderID(ctx context.Context,
 	}
 
 	// Get bids by tender id.
-	bids, err := s.bidRepo.GetByTenderID(ctx, tender.ID, limit, offset)
+	bids, err := s.bidRepo.GetByTenderID(ctx, tender.ID, round, limit, offset)
 	if err != nil {
 		return nil, NewTypedError("bidRepo.GetByTenderID", ErrorTypeInternal, err)
 	}
@@ -169,8 +254,11 @@ func (s *bidV1) GetByTenderID(ctx context.Context,
 	return bids, nil
 }
 
-// GetStatus.
-func (s *bidV1) GetStatus(ctx context.Context, username string, bidID uuid.UUID) (*entity.BidStatus, error) {
+// GetStatus returns bid, which status is requested.
+func (s *bidV1) GetStatus(ctx context.Context, username string, bidID uuid.UUID) (*entity.Bid, error) {
+	ctx, span := tracer.Start(ctx, "bidV1.GetStatus")
+	defer span.End()
+
 	// Verify user not associated with organization.
 	_, err := s.employeeService.GetUser(ctx, username)
 	if err != nil {
@@ -178,17 +266,15 @@ func (s *bidV1) GetStatus(ctx context.Context, username string, bidID uuid.UUID)
 	}
 
 	// Get bid by id.
-	bid, err := s.GetByID(ctx, bidID)
-	if err != nil {
-		return nil, err
-	}
-
-	return &bid.Status, nil
+	return s.GetByID(ctx, bidID)
 }
 
 // UpdateStatus.
 func (s *bidV1) UpdateStatus(ctx context.Context,
 	username string, bidID uuid.UUID, status entity.BidStatus) (*entity.Bid, error) {
+	ctx, span := tracer.Start(ctx, "bidV1.UpdateStatus")
+	defer span.End()
+
 	// Validate bid status.
 	if err := status.Validate(); err != nil {
 		return nil, NewTypedError("bid status is invalid", ErrorTypeInvalid, err)
@@ -233,6 +319,9 @@ func (s *bidV1) UpdateStatus(ctx context.Context,
 // Update.
 func (s *bidV1) Update(ctx context.Context,
 	username string, bidID uuid.UUID, data entity.BidData) (*entity.Bid, error) {
+	ctx, span := tracer.Start(ctx, "bidV1.Update")
+	defer span.End()
+
 	// Validate bid data.
 	if err := data.Validate(); err != nil {
 		return nil, NewTypedError("bid data is invalid", ErrorTypeInvalid, err)
@@ -265,6 +354,24 @@ func (s *bidV1) Update(ctx context.Context,
 		}
 	}
 
+	// Validate updated custom fields against schema of tender organization.
+	if data.CustomFields != nil {
+		tender, err := s.tenderService.GetByID(ctx, bid.TenderID)
+		if err != nil {
+			return nil, err
+		}
+		fields, err := s.fieldService.GetSchema(ctx, tender.OrganizationID, entity.CustomFieldBid)
+		if err != nil {
+			return nil, err
+		}
+		if err = data.CustomFields.ValidateValues(fields); err != nil {
+			return nil, NewTypedError("bid custom fields are invalid", ErrorTypeInvalid, err)
+		}
+		if err = bid.CustomFields.Apply(data.CustomFields).ValidateRequired(fields); err != nil {
+			return nil, NewTypedError("bid custom fields are invalid", ErrorTypeInvalid, err)
+		}
+	}
+
 	// Update bid data.
 	bid, err = s.bidRepo.Update(ctx, bid.ID, data)
 	if err != nil {
@@ -277,6 +384,9 @@ func (s *bidV1) Update(ctx context.Context,
 // SubmitDecision.
 func (s *bidV1) SubmitDecision(ctx context.Context,
 	username string, bidID uuid.UUID, decisionType entity.BidStatus) (*entity.Bid, error) {
+	ctx, span := tracer.Start(ctx, "bidV1.SubmitDecision")
+	defer span.End()
+
 	// Validate bid decision type.
 	if err := decisionType.ValidateDesicion(); err != nil {
 		return nil, NewTypedError("bid decision is invalid", ErrorTypeInvalid, err)
@@ -304,32 +414,41 @@ func (s *bidV1) SubmitDecision(ctx context.Context,
 		return nil, ErrTenderNotPublished
 	}
 
+	// Verify bid round.
+	if bid.Round != tender.Round {
+		return nil, ErrBidNotInRound
+	}
+
 	// Verify employee associated with organization.
 	employee, err := s.employeeService.GetEmployee(ctx, username, tender.OrganizationID)
 	if err != nil {
 		return nil, err
 	}
 
-	if decisionType != entity.BidRejected {
+	// Create bid decision on current content version of bid, so status changes keep it.
+	// Latest decision of employee replaces previous ones.
+	_, err = s.decisionRepo.Create(ctx, entity.BidDecision{
+		BidID:          bid.ID,
+		Type:           decisionType,
+		OrganizationID: tender.OrganizationID,
+		CreatorID:      employee.ID,
+		BidVersion:     bid.ContentVersion,
+	})
+	if err != nil {
+		return nil, NewTypedError("decisionRepo.Create", ErrorTypeInternal, err)
+	}
+
+	// Single rejection is final unless rejections are counted against quorum.
+	if decisionType != entity.BidRejected || s.rejectRule == BidRejectQuorum {
 		// Get employees by organization.
 		employees, err := s.employeeService.GetByOrganization(ctx, tender.OrganizationID)
 		if err != nil {
 			return nil, err
 		}
 
-		// Create bid decision.
-		_, err = s.decisionRepo.Create(ctx, entity.BidDecision{
-			BidID:          bid.ID,
-			Type:           decisionType,
-			OrganizationID: tender.OrganizationID,
-			CreatorID:      employee.ID,
-		})
-		if err != nil {
-			return nil, NewTypedError("decisionRepo.Create", ErrorTypeInternal, err)
-		}
-
-		// Get bid decisions.
-		decisions, err := s.decisionRepo.GetByBidID(ctx, bid.ID, tender.OrganizationID, &decisionType)
+		// Get bid decisions on current content version of bid.
+		decisions, err := s.decisionRepo.GetByBidID(ctx,
+			bid.ID, bid.ContentVersion, tender.OrganizationID, &decisionType)
 		if err != nil {
 			return nil, NewTypedError("decisionRepo.GetByBidID", ErrorTypeInternal, err)
 		}
@@ -358,8 +477,56 @@ func (s *bidV1) SubmitDecision(ctx context.Context,
 	return bid, nil
 }
 
+// RevokeDecision.
+func (s *bidV1) RevokeDecision(ctx context.Context, username string, bidID uuid.UUID) (*entity.Bid, error) {
+	ctx, span := tracer.Start(ctx, "bidV1.RevokeDecision")
+	defer span.End()
+
+	// Get bid by id.
+	bid, err := s.GetByID(ctx, bidID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify bid status.
+	if bid.Status != entity.BidPublished {
+		return nil, ErrBidNotPublished
+	}
+
+	// Get tender by id.
+	tender, err := s.tenderService.GetByID(ctx, bid.TenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify tender status.
+	if tender.Status != entity.TenderPublished {
+		return nil, ErrTenderNotPublished
+	}
+
+	// Verify employee associated with organization.
+	employee, err := s.employeeService.GetEmployee(ctx, username, tender.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Revoke latest employee decision on current content version of bid.
+	_, err = s.decisionRepo.Revoke(ctx, bid.ID, bid.ContentVersion, employee.ID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNoRows) {
+			return nil, ErrBidDecisionNotExist
+		}
+		return nil, NewTypedError("decisionRepo.Revoke", ErrorTypeInternal, err)
+	}
+
+	return bid, nil
+}
+
 // Rollback.
 func (s *bidV1) Rollback(ctx context.Context, username string, bidID uuid.UUID, version int) (*entity.Bid, error) {
+	ctx, span := tracer.Start(ctx, "bidV1.Rollback")
+	defer span.End()
+
 	// Validate bid version.
 	if version < 1 {
 		return nil, ErrBidVersion
@@ -399,6 +566,246 @@ func (s *bidV1) Rollback(ctx context.Context, username string, bidID uuid.UUID,
 	return bid, nil
 }
 
+// Shortlist.
+func (s *bidV1) Shortlist(ctx context.Context, username string, bidID uuid.UUID) (*entity.Bid, error) {
+	ctx, span := tracer.Start(ctx, "bidV1.Shortlist")
+	defer span.End()
+
+	// Get bid by id.
+	bid, err := s.GetByID(ctx, bidID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify bid status.
+	if bid.Status != entity.BidPublished {
+		return nil, ErrBidNotPublished
+	}
+
+	// Get tender by id.
+	tender, err := s.tenderService.GetByID(ctx, bid.TenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify tender status.
+	if tender.Status != entity.TenderPublished {
+		return nil, ErrTenderNotPublished
+	}
+
+	// Verify bid round.
+	if bid.Round != tender.Round {
+		return nil, ErrBidNotInRound
+	}
+
+	// Verify employee associated with organization.
+	employee, err := s.employeeService.GetEmployee(ctx, username, tender.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create bid shortlist.
+	_, err = s.shortlistRepo.Create(ctx, entity.BidShortlist{
+		BidID:          bid.ID,
+		TenderID:       tender.ID,
+		Round:          bid.Round,
+		OrganizationID: &tender.OrganizationID,
+		CreatorID:      employee.ID,
+	})
+	if err != nil {
+		return nil, NewTypedError("shortlistRepo.Create", ErrorTypeInternal, err)
+	}
+
+	return bid, nil
+}
+
+// Withdraw.
+func (s *bidV1) Withdraw(ctx context.Context, username string, bidID uuid.UUID, reason string) (*entity.Bid, error) {
+	ctx, span := tracer.Start(ctx, "bidV1.Withdraw")
+	defer span.End()
+
+	// Validate bid reason.
+	if err := entity.ValidateBidReason(reason); err != nil {
+		return nil, NewTypedError("bid reason is invalid", ErrorTypeInvalid, err)
+	}
+
+	// Get bid by id.
+	bid, err := s.GetByID(ctx, bidID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify bid status.
+	if bid.Status != entity.BidPublished {
+		return nil, ErrBidNotPublished
+	}
+
+	// Verify employee ot private user.
+	if bid.OrganizationID != nil {
+		_, err = s.employeeService.GetEmployee(ctx, username, *bid.OrganizationID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		user, err := s.employeeService.GetUser(ctx, username)
+		if err != nil {
+			return nil, err
+		}
+		if user.ID != bid.CreatorID {
+			return nil, ErrBidCreator
+		}
+	}
+
+	// Create canceled bid version with reason.
+	bid, err = s.bidRepo.UpdateStatusWithReason(ctx, bid.ID, entity.BidCanceled, reason)
+	if err != nil {
+		return nil, NewTypedError("bidRepo.UpdateStatusWithReason", ErrorTypeInternal, err)
+	}
+
+	return bid, nil
+}
+
+// Resubmit.
+func (s *bidV1) Resubmit(ctx context.Context, username string, bidID uuid.UUID, reason string) (*entity.Bid, error) {
+	ctx, span := tracer.Start(ctx, "bidV1.Resubmit")
+	defer span.End()
+
+	// Validate bid reason.
+	if err := entity.ValidateBidReason(reason); err != nil {
+		return nil, NewTypedError("bid reason is invalid", ErrorTypeInvalid, err)
+	}
+
+	// Get bid by id.
+	bid, err := s.GetByID(ctx, bidID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify bid status.
+	if bid.Status != entity.BidCanceled {
+		return nil, ErrBidNotCanceled
+	}
+
+	// Verify employee ot private user.
+	if bid.OrganizationID != nil {
+		_, err = s.employeeService.GetEmployee(ctx, username, *bid.OrganizationID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		user, err := s.employeeService.GetUser(ctx, username)
+		if err != nil {
+			return nil, err
+		}
+		if user.ID != bid.CreatorID {
+			return nil, ErrBidCreator
+		}
+	}
+
+	// Get tender by id.
+	tender, err := s.tenderService.GetByID(ctx, bid.TenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify tender status.
+	if tender.Status != entity.TenderPublished {
+		return nil, ErrTenderNotPublished
+	}
+
+	// Verify bid round.
+	if bid.Round != tender.Round {
+		return nil, ErrBidNotInRound
+	}
+
+	// Get last published bid version.
+	published, err := s.bidRepo.GetLastByStatus(ctx, bid.ID, entity.BidPublished)
+	if err != nil && !errors.Is(err, repo.ErrNoRows) {
+		return nil, NewTypedError("bidRepo.GetLastByStatus", ErrorTypeInternal, err)
+	}
+
+	// Reset bid decisions if bid was changed after withdrawal and create published bid version with reason
+	// in single transaction, so decisions are not reset if bid stays canceled.
+	err = s.transactor.InTx(ctx, func(ctx context.Context) error {
+		if published != nil && published.ContentVersion != bid.ContentVersion {
+			if err := s.decisionRepo.Reset(ctx, bid.ID); err != nil {
+				return NewTypedError("decisionRepo.Reset", ErrorTypeInternal, err)
+			}
+		}
+
+		bid, err = s.bidRepo.UpdateStatusWithReason(ctx, bid.ID, entity.BidPublished, reason)
+		if err != nil {
+			return NewTypedError("bidRepo.UpdateStatusWithReason", ErrorTypeInternal, err)
+		}
+		return nil
+	})
+	if err != nil {
+		var serviceErr *Error
+		if !errors.As(err, &serviceErr) {
+			return nil, NewTypedError("transactor.InTx", ErrorTypeInternal, err)
+		}
+		return nil, err
+	}
+
+	return bid, nil
+}
+
+// GetDecisions.
+func (s *bidV1) GetDecisions(ctx context.Context,
+	username string, bidID uuid.UUID, limit int, offset int) ([]entity.BidDecision, error) {
+	ctx, span := tracer.Start(ctx, "bidV1.GetDecisions")
+	defer span.End()
+
+	// Validate limit.
+	limit, err := s.getLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate offset.
+	if offset < 0 {
+		return nil, ErrBidOffset
+	}
+
+	// Get bid by id.
+	bid, err := s.GetByID(ctx, bidID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get tender by id.
+	tender, err := s.tenderService.GetByID(ctx, bid.TenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify employee associated with organization or bid creator.
+	redacted := false
+	_, err = s.employeeService.GetEmployee(ctx, username, tender.OrganizationID)
+	if errors.Is(err, ErrEmployeeForbidden) {
+		err = s.VerifyCreator(ctx, username, bid)
+		redacted = true
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Get bid decision history.
+	decisions, err := s.decisionRepo.GetHistoryByBidID(ctx, bid.ID, limit, offset)
+	if err != nil {
+		return nil, NewTypedError("decisionRepo.GetHistoryByBidID", ErrorTypeInternal, err)
+	}
+
+	// Hide decision creators from bid creator.
+	if redacted {
+		for i := range decisions {
+			decisions[i] = decisions[i].Redacted()
+		}
+	}
+
+	return decisions, nil
+}
+
 // bidReviewV1.
 type bidReviewV1 struct {
 	reviewRepo      repo.BidReview
@@ -418,6 +825,9 @@ func NewBidReviewV1(
 // Create.
 func (s *bidReviewV1) Create(ctx context.Context,
 	username string, bidID uuid.UUID, description string) (*entity.Bid, error) {
+	ctx, span := tracer.Start(ctx, "bidReviewV1.Create")
+	defer span.End()
+
 	// Get bid by id.
 	bid, err := s.bidService.GetByID(ctx, bidID)
 	if err != nil {
@@ -473,6 +883,9 @@ func (s *bidReviewV1) getLimit(limit int) (int, error) {
 func (s *bidReviewV1) GetByBidCreator(ctx context.Context,
 	requesterUsername string, creatorUsername string, tenderID uuid.UUID,
 	limit int, offset int) ([]entity.BidReview, error) {
+	ctx, span := tracer.Start(ctx, "bidReviewV1.GetByBidCreator")
+	defer span.End()
+
 	// Validate limit.
 	limit, err := s.getLimit(limit)
 	if err != nil {
@@ -519,3 +932,104 @@ func (s *bidReviewV1) GetByBidCreator(ctx context.Context,
 
 	return reviews, nil
 }
+
+// GetByBid.
+func (s *bidReviewV1) GetByBid(ctx context.Context,
+	username string, bidID uuid.UUID, limit int, offset int) ([]entity.BidReview, error) {
+	ctx, span := tracer.Start(ctx, "bidReviewV1.GetByBid")
+	defer span.End()
+
+	// Validate limit.
+	limit, err := s.getLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate offset.
+	if offset < 0 {
+		return nil, ErrBidReviewOffset
+	}
+
+	// Get bid by id.
+	bid, err := s.bidService.GetByID(ctx, bidID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get tender by id.
+	tender, err := s.tenderService.GetByID(ctx, bid.TenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify employee associated with organization or bid creator.
+	redacted := false
+	_, err = s.employeeService.GetEmployee(ctx, username, tender.OrganizationID)
+	if errors.Is(err, ErrEmployeeForbidden) {
+		err = s.bidService.VerifyCreator(ctx, username, bid)
+		redacted = true
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Get bid reviews by bid id.
+	reviews, err := s.reviewRepo.GetByBidID(ctx, bid.ID, limit, offset)
+	if err != nil {
+		return nil, NewTypedError("reviewRepo.GetByBidID", ErrorTypeInternal, err)
+	}
+
+	// Hide review creators from bid creator.
+	if redacted {
+		for i := range reviews {
+			reviews[i] = reviews[i].Redacted()
+		}
+	}
+
+	return reviews, nil
+}
+
+// GetByAuthor.
+func (s *bidReviewV1) GetByAuthor(ctx context.Context,
+	username string, tenderID *uuid.UUID, limit int, offset int) ([]entity.BidReview, error) {
+	ctx, span := tracer.Start(ctx, "bidReviewV1.GetByAuthor")
+	defer span.End()
+
+	// Validate limit.
+	limit, err := s.getLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate offset.
+	if offset < 0 {
+		return nil, ErrBidReviewOffset
+	}
+
+	// Get user by username.
+	user, err := s.employeeService.GetUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify tender exists.
+	if tenderID != nil {
+		_, err = s.tenderService.GetByID(ctx, *tenderID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Get reviews on bids created by user or user organizations.
+	reviews, err := s.reviewRepo.GetByBidAuthor(ctx, user.ID, tenderID, limit, offset)
+	if err != nil {
+		return nil, NewTypedError("reviewRepo.GetByBidAuthor", ErrorTypeInternal, err)
+	}
+
+	// Hide review creators from bid author.
+	for i := range reviews {
+		reviews[i] = reviews[i].Redacted()
+	}
+
+	return reviews, nil
+}
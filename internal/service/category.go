@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+)
+
+var (
+	ErrServiceCategoryNotExist       = NewTypedError("service category does not exist", ErrorTypeNotExist, nil)
+	ErrServiceCategoryUnknown        = NewTypedError("service category is unknown", ErrorTypeInvalid, nil)
+	ErrServiceCategoryParentNotExist = NewTypedError("parent service category does not exist", ErrorTypeInvalid, nil)
+	ErrServiceCategoryExist          = NewTypedError("service category already exists", ErrorTypeInvalid, nil)
+	ErrServiceCategoryCycle          = NewTypedError("service category cannot be nested in itself", ErrorTypeInvalid, nil)
+	ErrServiceCategoryInUse          = NewTypedError(
+		"service category has subcategories or is used by tenders or searches", ErrorTypeInvalid, nil,
+	)
+)
+
+type ServiceCategory interface {
+	Verify(ctx context.Context, names ...entity.TenderServiceType) error
+
+	GetAll(ctx context.Context) ([]entity.ServiceCategory, error)
+	Create(ctx context.Context, username string, category entity.ServiceCategory) (*entity.ServiceCategory, error)
+	Update(ctx context.Context, username string,
+		name entity.TenderServiceType, data entity.ServiceCategoryData) (*entity.ServiceCategory, error)
+	Delete(ctx context.Context, username string, name entity.TenderServiceType) (*entity.ServiceCategory, error)
+}
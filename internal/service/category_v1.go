@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/repo"
+)
+
+type serviceCategoryV1 struct {
+	categoryRepo    repo.ServiceCategory
+	employeeService Employee
+}
+
+func NewServiceCategoryV1(categoryRepo repo.ServiceCategory, employeeService Employee) ServiceCategory {
+	if categoryRepo == nil || employeeService == nil {
+		return nil
+	}
+	return &serviceCategoryV1{categoryRepo, employeeService}
+}
+
+func (s *serviceCategoryV1) getByName(ctx context.Context,
+	name entity.TenderServiceType) (*entity.ServiceCategory, error) {
+	category, err := s.categoryRepo.GetByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, repo.ErrNoRows) {
+			return nil, ErrServiceCategoryNotExist
+		}
+		return nil, NewTypedError("categoryRepo.GetByName", ErrorTypeInternal, err)
+	}
+	return category, nil
+}
+
+// Verify.
+func (s *serviceCategoryV1) Verify(ctx context.Context, names ...entity.TenderServiceType) error {
+	ctx, span := tracer.Start(ctx, "serviceCategoryV1.Verify")
+	defer span.End()
+
+	if len(names) == 0 {
+		return nil
+	}
+
+	// Get categories by names.
+	categories, err := s.categoryRepo.GetByNames(ctx, names)
+	if err != nil {
+		return NewTypedError("categoryRepo.GetByNames", ErrorTypeInternal, err)
+	}
+
+	// Verify each name matches category.
+	for _, name := range names {
+		found := slices.ContainsFunc(categories, func(category entity.ServiceCategory) bool {
+			return category.Name == name
+		})
+		if !found {
+			return ErrServiceCategoryUnknown
+		}
+	}
+
+	return nil
+}
+
+// GetAll.
+func (s *serviceCategoryV1) GetAll(ctx context.Context) ([]entity.ServiceCategory, error) {
+	ctx, span := tracer.Start(ctx, "serviceCategoryV1.GetAll")
+	defer span.End()
+
+	categories, err := s.categoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, NewTypedError("categoryRepo.GetAll", ErrorTypeInternal, err)
+	}
+	return categories, nil
+}
+
+// Create.
+func (s *serviceCategoryV1) Create(ctx context.Context,
+	username string, category entity.ServiceCategory) (*entity.ServiceCategory, error) {
+	ctx, span := tracer.Start(ctx, "serviceCategoryV1.Create")
+	defer span.End()
+
+	// Validate category data.
+	if err := category.Validate(); err != nil {
+		return nil, NewTypedError("service category data is invalid", ErrorTypeInvalid, err)
+	}
+
+	// Verify administrator.
+	_, err := s.employeeService.GetAdmin(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify parent category exists.
+	if category.ParentName != nil {
+		_, err = s.getByName(ctx, *category.ParentName)
+		if err != nil {
+			if errors.Is(err, ErrServiceCategoryNotExist) {
+				return nil, ErrServiceCategoryParentNotExist
+			}
+			return nil, err
+		}
+	}
+
+	// Create category.
+	createdCategory, err := s.categoryRepo.Create(ctx, category)
+	if err != nil {
+		if errors.Is(err, repo.ErrNoRows) {
+			return nil, ErrServiceCategoryExist
+		}
+		return nil, NewTypedError("categoryRepo.Create", ErrorTypeInternal, err)
+	}
+
+	return createdCategory, nil
+}
+
+// Update.
+func (s *serviceCategoryV1) Update(ctx context.Context, username string,
+	name entity.TenderServiceType, data entity.ServiceCategoryData) (*entity.ServiceCategory, error) {
+	ctx, span := tracer.Start(ctx, "serviceCategoryV1.Update")
+	defer span.End()
+
+	// Validate category data.
+	if err := data.Validate(); err != nil {
+		return nil, NewTypedError("service category data is invalid", ErrorTypeInvalid, err)
+	}
+
+	// Verify administrator.
+	_, err := s.employeeService.GetAdmin(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get category by name.
+	category, err := s.getByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify new parent exists and is not nested in category.
+	if data.ParentName != nil && *data.ParentName != "" {
+		parentName := data.ParentName
+		for parentName != nil {
+			if *parentName == category.Name {
+				return nil, ErrServiceCategoryCycle
+			}
+			parent, err := s.getByName(ctx, *parentName)
+			if err != nil {
+				if errors.Is(err, ErrServiceCategoryNotExist) {
+					return nil, ErrServiceCategoryParentNotExist
+				}
+				return nil, err
+			}
+			parentName = parent.ParentName
+		}
+	}
+
+	// Update category.
+	category, err = s.categoryRepo.Update(ctx, category.Name, data)
+	if err != nil {
+		if errors.Is(err, repo.ErrNoRows) {
+			return nil, ErrServiceCategoryNotExist
+		}
+		return nil, NewTypedError("categoryRepo.Update", ErrorTypeInternal, err)
+	}
+
+	return category, nil
+}
+
+// Delete.
+func (s *serviceCategoryV1) Delete(ctx context.Context,
+	username string, name entity.TenderServiceType) (*entity.ServiceCategory, error) {
+	ctx, span := tracer.Start(ctx, "serviceCategoryV1.Delete")
+	defer span.End()
+
+	// Verify administrator.
+	_, err := s.employeeService.GetAdmin(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get category by name.
+	category, err := s.getByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Delete category not used by subcategories, tenders and searches.
+	category, err = s.categoryRepo.Delete(ctx, category.Name)
+	if err != nil {
+		if errors.Is(err, repo.ErrNoRows) {
+			return nil, ErrServiceCategoryInUse
+		}
+		return nil, NewTypedError("categoryRepo.Delete", ErrorTypeInternal, err)
+	}
+
+	return category, nil
+}
@@ -10,10 +10,12 @@ import (
 var (
 	ErrEmployeeUnauthorized = NewTypedError("unauthorized user", ErrorTypeUnauthorized, nil)
 	ErrEmployeeForbidden    = NewTypedError("user is not an employee of organization", ErrorTypeForbidden, nil)
+	ErrEmployeeNotAdmin     = NewTypedError("user is not an administrator", ErrorTypeForbidden, nil)
 )
 
 type Employee interface {
 	GetUser(ctx context.Context, username string) (*entity.Employee, error)
 	GetEmployee(ctx context.Context, username string, organizationID uuid.UUID) (*entity.Employee, error)
 	GetByOrganization(ctx context.Context, organizationID uuid.UUID) ([]entity.Employee, error)
+	GetAdmin(ctx context.Context, username string) (*entity.Employee, error)
 }
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/cache"
+	"github.com/google/uuid"
+)
+
+// employeeCache caches users and employees, which are verified by most of service methods.
+// Errors are not cached.
+type employeeCache struct {
+	Employee
+	cache cache.Cache
+	ttl   time.Duration
+}
+
+func NewEmployeeCache(employeeService Employee, c cache.Cache, ttl time.Duration) Employee {
+	if employeeService == nil || c == nil {
+		return nil
+	}
+	return &employeeCache{employeeService, c, ttl}
+}
+
+func (s *employeeCache) GetUser(ctx context.Context, username string) (*entity.Employee, error) {
+	return cache.Load(ctx, s.cache, "service:employee:user:"+username, s.ttl, func() (*entity.Employee, error) {
+		return s.Employee.GetUser(ctx, username)
+	})
+}
+
+func (s *employeeCache) GetEmployee(ctx context.Context,
+	username string, organizationID uuid.UUID) (*entity.Employee, error) {
+	return cache.Load(ctx, s.cache, "service:employee:employee:"+username+":"+organizationID.String(), s.ttl,
+		func() (*entity.Employee, error) {
+			return s.Employee.GetEmployee(ctx, username, organizationID)
+		})
+}
+
+func (s *employeeCache) GetByOrganization(ctx context.Context, organizationID uuid.UUID) ([]entity.Employee, error) {
+	return cache.Load(ctx, s.cache, "service:employee:organization:"+organizationID.String(), s.ttl,
+		func() ([]entity.Employee, error) {
+			return s.Employee.GetByOrganization(ctx, organizationID)
+		})
+}
+
+func (s *employeeCache) GetAdmin(ctx context.Context, username string) (*entity.Employee, error) {
+	return cache.Load(ctx, s.cache, "service:employee:admin:"+username, s.ttl, func() (*entity.Employee, error) {
+		return s.Employee.GetAdmin(ctx, username)
+	})
+}
@@ -0,0 +1,52 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/service"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/cache"
+	"github.com/google/uuid"
+)
+
+const benchEmployeeLatency = time.Millisecond // simulated database round trip
+
+// employeeFake returns employees after simulated latency.
+type employeeFake struct {
+	service.Employee
+}
+
+func (employeeFake) GetEmployee(_ context.Context,
+	username string, organizationID uuid.UUID) (*entity.Employee, error) {
+	time.Sleep(benchEmployeeLatency)
+	return &entity.Employee{ID: uuid.New(), Username: username}, nil
+}
+
+// BenchmarkEmployeeCacheGetEmployee compares concurrent employee verifications with and without cache.
+func BenchmarkEmployeeCacheGetEmployee(b *testing.B) {
+	usernames := []string{"user1", "user2", "user3", "user4", "user5", "user6", "user7", "user8"}
+	organizationID := uuid.New()
+
+	benchmarks := []struct {
+		name     string
+		employee service.Employee
+	}{
+		{"uncached", employeeFake{}},
+		{"lru", service.NewEmployeeCache(employeeFake{}, cache.NewLRU(1024), time.Minute)},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			ctx := context.Background()
+			b.RunParallel(func(pb *testing.PB) {
+				for i := 0; pb.Next(); i++ {
+					if _, err := bm.employee.GetEmployee(ctx, usernames[i%len(usernames)], organizationID); err != nil {
+						b.Error(err)
+						return
+					}
+				}
+			})
+		})
+	}
+}
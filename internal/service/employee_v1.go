@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
 	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/repo"
@@ -10,17 +11,21 @@ import (
 )
 
 type employeeV1 struct {
-	employeeRepo repo.Employee
+	employeeRepo   repo.Employee
+	adminUsernames []string
 }
 
-func NewEmployeeV1(employee repo.Employee) Employee {
+func NewEmployeeV1(employee repo.Employee, adminUsernames []string) Employee {
 	if employee == nil {
 		return nil
 	}
-	return &employeeV1{employee}
+	return &employeeV1{employee, adminUsernames}
 }
 
 func (s *employeeV1) GetUser(ctx context.Context, username string) (*entity.Employee, error) {
+	ctx, span := tracer.Start(ctx, "employeeV1.GetUser")
+	defer span.End()
+
 	employee, err := s.employeeRepo.GetByUsername(ctx, username)
 	if err != nil {
 		if errors.Is(err, repo.ErrNoRows) {
@@ -33,6 +38,9 @@ func (s *employeeV1) GetUser(ctx context.Context, username string) (*entity.Empl
 
 func (s *employeeV1) GetEmployee(ctx context.Context,
 	username string, organizationID uuid.UUID) (*entity.Employee, error) {
+	ctx, span := tracer.Start(ctx, "employeeV1.GetEmployee")
+	defer span.End()
+
 	employee, err := s.GetUser(ctx, username)
 	if err != nil {
 		return nil, err
@@ -50,6 +58,9 @@ func (s *employeeV1) GetEmployee(ctx context.Context,
 }
 
 func (s *employeeV1) GetByOrganization(ctx context.Context, organizationID uuid.UUID) ([]entity.Employee, error) {
+	ctx, span := tracer.Start(ctx, "employeeV1.GetByOrganization")
+	defer span.End()
+
 	employees, err := s.employeeRepo.GetByOrganization(ctx, organizationID)
 	if err != nil {
 		return nil, NewTypedError("employeeRepo.GetByOrganization", ErrorTypeInternal, err)
@@ -57,3 +68,19 @@ func (s *employeeV1) GetByOrganization(ctx context.Context, organizationID uuid.
 
 	return employees, nil
 }
+
+func (s *employeeV1) GetAdmin(ctx context.Context, username string) (*entity.Employee, error) {
+	ctx, span := tracer.Start(ctx, "employeeV1.GetAdmin")
+	defer span.End()
+
+	employee, err := s.GetUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(s.adminUsernames, employee.Username) {
+		return nil, ErrEmployeeNotAdmin
+	}
+
+	return employee, nil
+}
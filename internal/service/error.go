@@ -1,5 +1,13 @@
 package service
 
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strings"
+)
+
 type ErrorType string
 
 const (
@@ -10,16 +18,67 @@ const (
 	ErrorTypeInternal     ErrorType = "internal"     // 500
 )
 
+const errorStackDepth = 32
+
 type Error struct {
 	msg   string
 	etype ErrorType
 	err   error
+	stack []uintptr
 }
 
-func NewTypedError(msg string, etype ErrorType, err error) error { return &Error{msg, etype, err} }
+// NewTypedError returns typed error.
+// Errors wrapping another error are created at call site, so their stack is captured.
+func NewTypedError(msg string, etype ErrorType, err error) error {
+	e := &Error{msg: msg, etype: etype, err: err}
+	if err != nil {
+		pcs := make([]uintptr, errorStackDepth)
+		e.stack = pcs[:runtime.Callers(2, pcs)]
+	}
+	return e
+}
 
 func (e *Error) Error() string { return e.msg }
 
 func (e *Error) Unwrap() error { return e.err }
 
 func (e *Error) Type() ErrorType { return e.etype }
+
+// Stack returns formatted stack captured on error creation, or empty string if not captured.
+func (e *Error) Stack() string {
+	if len(e.stack) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	frames := runtime.CallersFrames(e.stack)
+	for {
+		frame, more := frames.Next()
+		fmt.Fprintf(&b, "%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		if !more {
+			break
+		}
+	}
+	return b.String()
+}
+
+// ErrorChain returns error message including errors wrapped by service errors, which are omitted by Error.
+func ErrorChain(err error) string {
+	var serviceErr *Error
+	if errors.As(err, &serviceErr) && serviceErr.Unwrap() != nil {
+		return serviceErr.Error() + ": " + ErrorChain(serviceErr.Unwrap())
+	}
+	return err.Error()
+}
+
+// ErrorStack returns stack captured by service error.
+// Falls back to current stack if error has none.
+func ErrorStack(err error) string {
+	var serviceErr *Error
+	for e := err; errors.As(e, &serviceErr); e = serviceErr.Unwrap() {
+		if stack := serviceErr.Stack(); stack != "" {
+			return stack
+		}
+	}
+	return string(debug.Stack())
+}
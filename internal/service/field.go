@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCustomFieldNotExist = NewTypedError("custom field does not exist", ErrorTypeNotExist, nil)
+	ErrCustomFieldExist    = NewTypedError("custom field already exists", ErrorTypeInvalid, nil)
+)
+
+type CustomField interface {
+	GetSchema(ctx context.Context,
+		organizationID uuid.UUID, target entity.CustomFieldTarget) ([]entity.CustomField, error)
+
+	Create(ctx context.Context, username string, field entity.CustomField) (*entity.CustomField, error)
+	GetByOrganization(ctx context.Context, username string,
+		organizationID uuid.UUID, target *entity.CustomFieldTarget) ([]entity.CustomField, error)
+	Delete(ctx context.Context,
+		username string, organizationID uuid.UUID, fieldID uuid.UUID) (*entity.CustomField, error)
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/repo"
+	"github.com/google/uuid"
+)
+
+type customFieldV1 struct {
+	fieldRepo       repo.CustomField
+	employeeService Employee
+}
+
+func NewCustomFieldV1(fieldRepo repo.CustomField, employeeService Employee) CustomField {
+	if fieldRepo == nil || employeeService == nil {
+		return nil
+	}
+	return &customFieldV1{fieldRepo, employeeService}
+}
+
+// GetSchema.
+func (s *customFieldV1) GetSchema(ctx context.Context,
+	organizationID uuid.UUID, target entity.CustomFieldTarget) ([]entity.CustomField, error) {
+	ctx, span := tracer.Start(ctx, "customFieldV1.GetSchema")
+	defer span.End()
+
+	fields, err := s.fieldRepo.GetByOrganizationID(ctx, organizationID, &target)
+	if err != nil {
+		return nil, NewTypedError("fieldRepo.GetByOrganizationID", ErrorTypeInternal, err)
+	}
+	return fields, nil
+}
+
+// Create.
+func (s *customFieldV1) Create(ctx context.Context,
+	username string, field entity.CustomField) (*entity.CustomField, error) {
+	ctx, span := tracer.Start(ctx, "customFieldV1.Create")
+	defer span.End()
+
+	// Validate custom field data.
+	if err := field.Validate(); err != nil {
+		return nil, NewTypedError("custom field data is invalid", ErrorTypeInvalid, err)
+	}
+
+	// Get employee associated with organization.
+	employee, err := s.employeeService.GetEmployee(ctx, username, field.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create custom field.
+	field.CreatorID = employee.ID
+	createdField, err := s.fieldRepo.Create(ctx, field)
+	if err != nil {
+		if errors.Is(err, repo.ErrNoRows) {
+			return nil, ErrCustomFieldExist
+		}
+		return nil, NewTypedError("fieldRepo.Create", ErrorTypeInternal, err)
+	}
+
+	return createdField, nil
+}
+
+// GetByOrganization.
+func (s *customFieldV1) GetByOrganization(ctx context.Context, username string,
+	organizationID uuid.UUID, target *entity.CustomFieldTarget) ([]entity.CustomField, error) {
+	ctx, span := tracer.Start(ctx, "customFieldV1.GetByOrganization")
+	defer span.End()
+
+	// Validate custom field target.
+	if target != nil {
+		if err := target.Validate(); err != nil {
+			return nil, NewTypedError("custom field target is invalid", ErrorTypeInvalid, err)
+		}
+	}
+
+	// Verify employee associated with organization.
+	_, err := s.employeeService.GetEmployee(ctx, username, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get custom fields by organization id.
+	fields, err := s.fieldRepo.GetByOrganizationID(ctx, organizationID, target)
+	if err != nil {
+		return nil, NewTypedError("fieldRepo.GetByOrganizationID", ErrorTypeInternal, err)
+	}
+
+	return fields, nil
+}
+
+// Delete.
+func (s *customFieldV1) Delete(ctx context.Context,
+	username string, organizationID uuid.UUID, fieldID uuid.UUID) (*entity.CustomField, error) {
+	ctx, span := tracer.Start(ctx, "customFieldV1.Delete")
+	defer span.End()
+
+	// Verify employee associated with organization.
+	_, err := s.employeeService.GetEmployee(ctx, username, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Delete custom field.
+	field, err := s.fieldRepo.Delete(ctx, organizationID, fieldID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNoRows) {
+			return nil, ErrCustomFieldNotExist
+		}
+		return nil, NewTypedError("fieldRepo.Delete", ErrorTypeInternal, err)
+	}
+
+	return field, nil
+}
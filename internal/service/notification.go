@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"github.com/google/uuid"
+)
+
+const (
+	NotificationLimitMax     = 100
+	NotificationLimitDefault = 5
+)
+
+var (
+	ErrNotificationNotExist = NewTypedError("notification does not exist", ErrorTypeNotExist, nil)
+	ErrNotificationLimit    = NewTypedError(
+		fmt.Sprintf("notification limit must be > 0 and <= %d", NotificationLimitMax), ErrorTypeInvalid, nil,
+	)
+	ErrNotificationOffset = NewTypedError("notification offset must be >= 0", ErrorTypeInvalid, nil)
+)
+
+type Notification interface {
+	GetByUsername(ctx context.Context,
+		username string, unread bool, limit int, offset int) ([]entity.Notification, error)
+	MarkRead(ctx context.Context, username string, notificationID uuid.UUID) (*entity.Notification, error)
+}
+
+// NotificationChannel delivers notification to users.
+// Deadline notification may be delivered again for same tender on next check, channel should skip repeats.
+type NotificationChannel interface {
+	Notify(ctx context.Context, notification entity.Notification, userIDs []uuid.UUID) error
+}
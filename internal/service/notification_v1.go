@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/repo"
+	"github.com/google/uuid"
+)
+
+type notificationV1 struct {
+	notificationRepo repo.Notification
+	employeeService  Employee
+}
+
+func NewNotificationV1(notificationRepo repo.Notification, employeeService Employee) Notification {
+	if notificationRepo == nil || employeeService == nil {
+		return nil
+	}
+	return &notificationV1{notificationRepo, employeeService}
+}
+
+func (s *notificationV1) getLimit(limit int) (int, error) {
+	if limit < 0 || limit > NotificationLimitMax {
+		return 0, ErrNotificationLimit
+	}
+
+	if limit == 0 {
+		return NotificationLimitDefault, nil
+	}
+
+	return limit, nil
+}
+
+// GetByUsername.
+func (s *notificationV1) GetByUsername(ctx context.Context,
+	username string, unread bool, limit int, offset int) ([]entity.Notification, error) {
+	ctx, span := tracer.Start(ctx, "notificationV1.GetByUsername")
+	defer span.End()
+
+	// Validate limit.
+	limit, err := s.getLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate offset.
+	if offset < 0 {
+		return nil, ErrNotificationOffset
+	}
+
+	// Get user by username.
+	user, err := s.employeeService.GetUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get notifications by user id.
+	notifications, err := s.notificationRepo.GetByUserID(ctx, user.ID, unread, limit, offset)
+	if err != nil {
+		return nil, NewTypedError("notificationRepo.GetByUserID", ErrorTypeInternal, err)
+	}
+
+	return notifications, nil
+}
+
+// MarkRead.
+func (s *notificationV1) MarkRead(ctx context.Context,
+	username string, notificationID uuid.UUID) (*entity.Notification, error) {
+	ctx, span := tracer.Start(ctx, "notificationV1.MarkRead")
+	defer span.End()
+
+	// Get user by username.
+	user, err := s.employeeService.GetUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Mark notification as read.
+	notification, err := s.notificationRepo.MarkRead(ctx, user.ID, notificationID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNoRows) {
+			return nil, ErrNotificationNotExist
+		}
+		return nil, NewTypedError("notificationRepo.MarkRead", ErrorTypeInternal, err)
+	}
+
+	return notification, nil
+}
+
+// notificationChannelInApp stores notifications to be read through notification endpoints.
+type notificationChannelInApp struct {
+	notificationRepo repo.Notification
+}
+
+func NewNotificationChannelInApp(notificationRepo repo.Notification) NotificationChannel {
+	if notificationRepo == nil {
+		return nil
+	}
+	return &notificationChannelInApp{notificationRepo}
+}
+
+func (c *notificationChannelInApp) Notify(ctx context.Context,
+	notification entity.Notification, userIDs []uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "notificationChannelInApp.Notify")
+	defer span.End()
+
+	_, err := c.notificationRepo.CreateForUsers(ctx, notification, userIDs)
+	return err
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/logctx"
+)
+
+// TenderQuestionNotifier is called after tender questions and answers are created.
+type TenderQuestionNotifier interface {
+	QuestionCreated(ctx context.Context, tender *entity.Tender, question *entity.TenderQuestion)
+	AnswerCreated(ctx context.Context,
+		tender *entity.Tender, question *entity.TenderQuestion, answer *entity.TenderAnswer)
+}
+
+// tenderQuestionNotifierLog logs by context logger, so log lines carry request id.
+type tenderQuestionNotifierLog struct{}
+
+func NewTenderQuestionNotifierLog() TenderQuestionNotifier {
+	return &tenderQuestionNotifierLog{}
+}
+
+func (n *tenderQuestionNotifierLog) QuestionCreated(ctx context.Context,
+	tender *entity.Tender, question *entity.TenderQuestion) {
+	logctx.From(ctx).InfoContext(ctx, "tender question created",
+		"tender_id", tender.ID,
+		"organization_id", tender.OrganizationID,
+		"question_id", question.ID)
+}
+
+func (n *tenderQuestionNotifierLog) AnswerCreated(ctx context.Context,
+	tender *entity.Tender, question *entity.TenderQuestion, answer *entity.TenderAnswer) {
+	logctx.From(ctx).InfoContext(ctx, "tender answer created",
+		"tender_id", tender.ID,
+		"question_id", question.ID,
+		"question_creator_id", question.CreatorID,
+		"answer_id", answer.ID,
+		"visibility", answer.Visibility)
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"github.com/google/uuid"
+)
+
+const (
+	TenderQuestionLimitMax     = 100
+	TenderQuestionLimitDefault = 5
+)
+
+var (
+	ErrTenderQuestionNotExist = NewTypedError("tender question does not exist", ErrorTypeNotExist, nil)
+	ErrTenderQuestionLimit    = NewTypedError(
+		fmt.Sprintf("tender question limit must be > 0 and <= %d", TenderQuestionLimitMax), ErrorTypeInvalid, nil,
+	)
+	ErrTenderQuestionOffset = NewTypedError("tender question offset must be >= 0", ErrorTypeInvalid, nil)
+)
+
+type TenderQuestion interface {
+	Create(ctx context.Context,
+		username string, tenderID uuid.UUID, description string) (*entity.TenderQuestion, error)
+	GetByTenderID(ctx context.Context,
+		username string, tenderID uuid.UUID, limit int, offset int) ([]entity.TenderQuestionThread, error)
+	Answer(ctx context.Context, username string, tenderID uuid.UUID, questionID uuid.UUID,
+		description string, visibility entity.TenderAnswerVisibility) (*entity.TenderAnswer, error)
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/repo"
+	"github.com/google/uuid"
+)
+
+type tenderQuestionV1 struct {
+	questionRepo    repo.TenderQuestion
+	answerRepo      repo.TenderAnswer
+	tenderService   Tender
+	employeeService Employee
+	notifier        TenderQuestionNotifier
+}
+
+func NewTenderQuestionV1(questionRepo repo.TenderQuestion, answerRepo repo.TenderAnswer,
+	tenderService Tender, employeeService Employee, notifier TenderQuestionNotifier) TenderQuestion {
+	if questionRepo == nil || answerRepo == nil || tenderService == nil || employeeService == nil || notifier == nil {
+		return nil
+	}
+	return &tenderQuestionV1{questionRepo, answerRepo, tenderService, employeeService, notifier}
+}
+
+// Create.
+func (s *tenderQuestionV1) Create(ctx context.Context,
+	username string, tenderID uuid.UUID, description string) (*entity.TenderQuestion, error) {
+	ctx, span := tracer.Start(ctx, "tenderQuestionV1.Create")
+	defer span.End()
+
+	// Get user by username.
+	user, err := s.employeeService.GetUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get tender by id.
+	tender, err := s.tenderService.GetByID(ctx, tenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify tender status.
+	if tender.Status != entity.TenderPublished {
+		return nil, ErrTenderNotPublished
+	}
+
+	// Verify user is invited to invite-only tender, unless user is employee associated with organization.
+	if tender.Visibility == entity.TenderInviteOnly {
+		_, err = s.employeeService.GetEmployee(ctx, username, tender.OrganizationID)
+		if err != nil {
+			if !errors.Is(err, ErrEmployeeForbidden) {
+				return nil, err
+			}
+			if err = s.tenderService.HasUserInvitation(ctx, tender.ID, user.ID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Set question.
+	question := entity.TenderQuestion{
+		TenderID:    tender.ID,
+		Description: description,
+		CreatorID:   user.ID,
+	}
+
+	if err = question.Validate(); err != nil {
+		return nil, NewTypedError("tender question data is invalid", ErrorTypeInvalid, err)
+	}
+
+	// Create question.
+	createdQuestion, err := s.questionRepo.Create(ctx, question)
+	if err != nil {
+		return nil, NewTypedError("questionRepo.Create", ErrorTypeInternal, err)
+	}
+
+	s.notifier.QuestionCreated(ctx, tender, createdQuestion)
+
+	return createdQuestion, nil
+}
+
+func (s *tenderQuestionV1) getLimit(limit int) (int, error) {
+	if limit < 0 || limit > TenderQuestionLimitMax {
+		return 0, ErrTenderQuestionLimit
+	}
+
+	if limit == 0 {
+		return TenderQuestionLimitDefault, nil
+	}
+
+	return limit, nil
+}
+
+// GetByTenderID.
+func (s *tenderQuestionV1) GetByTenderID(ctx context.Context,
+	username string, tenderID uuid.UUID, limit int, offset int) ([]entity.TenderQuestionThread, error) {
+	ctx, span := tracer.Start(ctx, "tenderQuestionV1.GetByTenderID")
+	defer span.End()
+
+	// Validate limit.
+	limit, err := s.getLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate offset.
+	if offset < 0 {
+		return nil, ErrTenderQuestionOffset
+	}
+
+	// Get user by username.
+	user, err := s.employeeService.GetUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get tender by id.
+	tender, err := s.tenderService.GetByID(ctx, tenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if user is employee associated with organization.
+	_, err = s.employeeService.GetEmployee(ctx, username, tender.OrganizationID)
+	isEmployee := err == nil
+	if err != nil && !errors.Is(err, ErrEmployeeForbidden) {
+		return nil, err
+	}
+
+	// Verify tender status.
+	if !isEmployee && tender.Status != entity.TenderPublished {
+		return nil, ErrTenderNotPublished
+	}
+
+	// Verify user is invited to invite-only tender.
+	if !isEmployee && tender.Visibility == entity.TenderInviteOnly {
+		if err = s.tenderService.HasUserInvitation(ctx, tender.ID, user.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Get questions by tender id.
+	questions, err := s.questionRepo.GetByTenderID(ctx, tender.ID, limit, offset)
+	if err != nil {
+		return nil, NewTypedError("questionRepo.GetByTenderID", ErrorTypeInternal, err)
+	}
+
+	questionIDs := make([]uuid.UUID, len(questions))
+	for i, question := range questions {
+		questionIDs[i] = question.ID
+	}
+
+	// Get answers by question ids.
+	answers, err := s.answerRepo.GetByQuestionIDs(ctx, questionIDs)
+	if err != nil {
+		return nil, NewTypedError("answerRepo.GetByQuestionIDs", ErrorTypeInternal, err)
+	}
+
+	// Group answers visible to user by question.
+	threads := make([]entity.TenderQuestionThread, len(questions))
+	indexes := make(map[uuid.UUID]int, len(questions))
+	for i, question := range questions {
+		threads[i].Question = question
+		threads[i].Answers = make([]entity.TenderAnswer, 0)
+		indexes[question.ID] = i
+	}
+
+	for _, answer := range answers {
+		i := indexes[answer.QuestionID]
+		if answer.Visibility == entity.TenderAnswerPrivate && !isEmployee && threads[i].Question.CreatorID != user.ID {
+			continue
+		}
+		threads[i].Answers = append(threads[i].Answers, answer)
+	}
+
+	return threads, nil
+}
+
+// Answer.
+func (s *tenderQuestionV1) Answer(ctx context.Context, username string, tenderID uuid.UUID, questionID uuid.UUID,
+	description string, visibility entity.TenderAnswerVisibility) (*entity.TenderAnswer, error) {
+	ctx, span := tracer.Start(ctx, "tenderQuestionV1.Answer")
+	defer span.End()
+
+	// Get tender by id.
+	tender, err := s.tenderService.GetByID(ctx, tenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify employee associated with organization.
+	employee, err := s.employeeService.GetEmployee(ctx, username, tender.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get question by id.
+	question, err := s.questionRepo.GetByID(ctx, questionID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNoRows) {
+			return nil, ErrTenderQuestionNotExist
+		}
+		return nil, NewTypedError("questionRepo.GetByID", ErrorTypeInternal, err)
+	}
+	if question.TenderID != tender.ID {
+		return nil, ErrTenderQuestionNotExist
+	}
+
+	// Set answer.
+	answer := entity.TenderAnswer{
+		QuestionID:     question.ID,
+		Description:    description,
+		Visibility:     visibility,
+		OrganizationID: &tender.OrganizationID,
+		CreatorID:      employee.ID,
+	}
+
+	if err = answer.Validate(); err != nil {
+		return nil, NewTypedError("tender answer data is invalid", ErrorTypeInvalid, err)
+	}
+
+	// Create answer.
+	createdAnswer, err := s.answerRepo.Create(ctx, answer)
+	if err != nil {
+		return nil, NewTypedError("answerRepo.Create", ErrorTypeInternal, err)
+	}
+
+	s.notifier.AnswerCreated(ctx, tender, question, createdAnswer)
+
+	return createdAnswer, nil
+}
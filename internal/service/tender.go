@@ -22,18 +22,45 @@ var (
 	ErrTenderOffset       = NewTypedError("tender offset must be >= 0", ErrorTypeInvalid, nil)
 	ErrTenderVersion      = NewTypedError("tender version must be greater than 0", ErrorTypeInvalid, nil)
 	ErrTenderNotPublished = NewTypedError("tender is not published", ErrorTypeInvalid, nil)
+	ErrTenderNoShortlist  = NewTypedError("tender has no shortlisted bids in current round", ErrorTypeInvalid, nil)
+	ErrTenderNotInvited   = NewTypedError("user is not invited to tender", ErrorTypeForbidden, nil)
+	ErrTenderOpenQuota    = NewTypedError("organization has reached open tenders quota", ErrorTypeForbidden, nil)
+
+	ErrTenderInvitationNotExist = NewTypedError("tender invitation does not exist", ErrorTypeNotExist, nil)
+	ErrTenderInvitationExist    = NewTypedError("tender invitation already exists", ErrorTypeInvalid, nil)
+	ErrTenderInviteeNotExist    = NewTypedError("invitee does not exist", ErrorTypeNotExist, nil)
+
+	ErrTenderTemplateNotExist = NewTypedError("tender template does not exist", ErrorTypeNotExist, nil)
 )
 
 type Tender interface {
 	GetByID(ctx context.Context, tenderID uuid.UUID) (*entity.Tender, error)
+	HasInvitation(ctx context.Context, tenderID uuid.UUID, userID uuid.UUID, organizationID *uuid.UUID) error
+	HasUserInvitation(ctx context.Context, tenderID uuid.UUID, userID uuid.UUID) error
 
-	GetByServiceType(ctx context.Context,
-		serviceTypes []entity.TenderServiceType, limit int, offset int) ([]entity.Tender, error)
+	GetByServiceType(ctx context.Context, filter entity.TenderFilter, limit int, offset int) ([]entity.Tender, error)
+	GetTags(ctx context.Context, limit int, offset int) ([]entity.TenderTag, error)
 	Create(ctx context.Context, username string, tender entity.Tender) (*entity.Tender, error)
 	GetByCreatorUsername(ctx context.Context, username string, limit int, offset int) ([]entity.Tender, error)
-	GetStatus(ctx context.Context, username string, tenderID uuid.UUID) (*entity.TenderStatus, error)
+	GetByInviteeUsername(ctx context.Context, username string, limit int, offset int) ([]entity.Tender, error)
+	GetStatus(ctx context.Context, username string, tenderID uuid.UUID) (*entity.Tender, error)
 	UpdateStatus(ctx context.Context,
 		username string, tenderID uuid.UUID, status entity.TenderStatus) (*entity.Tender, error)
 	Update(ctx context.Context, username string, tenderID uuid.UUID, data entity.TenderData) (*entity.Tender, error)
 	Rollback(ctx context.Context, username string, tenderID uuid.UUID, version int) (*entity.Tender, error)
+	NextRound(ctx context.Context, username string, tenderID uuid.UUID) (*entity.Tender, error)
+	Clone(ctx context.Context, username string, tenderID uuid.UUID) (*entity.Tender, error)
+
+	CreateInvitation(ctx context.Context, username string, tenderID uuid.UUID,
+		organizationID *uuid.UUID, inviteeUsername *string) (*entity.TenderInvitation, error)
+	GetInvitations(ctx context.Context,
+		username string, tenderID uuid.UUID, limit int, offset int) ([]entity.TenderInvitation, error)
+	DeleteInvitation(ctx context.Context,
+		username string, tenderID uuid.UUID, invitationID uuid.UUID) (*entity.TenderInvitation, error)
+
+	CreateTemplate(ctx context.Context, username string, tenderID uuid.UUID) (*entity.TenderTemplate, error)
+	GetTemplates(ctx context.Context,
+		username string, organizationID uuid.UUID, limit int, offset int) ([]entity.TenderTemplate, error)
+	CreateFromTemplate(ctx context.Context, username string, templateID uuid.UUID) (*entity.Tender, error)
+	DeleteTemplate(ctx context.Context, username string, templateID uuid.UUID) (*entity.TenderTemplate, error)
 }
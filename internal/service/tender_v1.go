@@ -11,18 +11,35 @@ import (
 
 type tenderV1 struct {
 	tenderRepo      repo.Tender
+	invitationRepo  repo.TenderInvitation
+	templateRepo    repo.TenderTemplate
+	shortlistRepo   repo.BidShortlist
+	categoryService ServiceCategory
+	fieldService    CustomField
 	employeeService Employee
+	matcher         TenderMatcher
+	transactor      repo.Transactor
+	maxOpen         int // max number of not closed tenders of organization, 0 is unlimited
 }
 
-func NewTenderV1(tenderRepo repo.Tender, employeeService Employee) Tender {
-	if tenderRepo == nil || employeeService == nil {
+func NewTenderV1(tenderRepo repo.Tender, invitationRepo repo.TenderInvitation, templateRepo repo.TenderTemplate,
+	shortlistRepo repo.BidShortlist, categoryService ServiceCategory, fieldService CustomField,
+	employeeService Employee, matcher TenderMatcher, transactor repo.Transactor, maxOpen int) Tender {
+	if tenderRepo == nil || invitationRepo == nil || templateRepo == nil || shortlistRepo == nil ||
+		categoryService == nil || fieldService == nil || employeeService == nil || matcher == nil || transactor == nil {
 		return nil
 	}
-	return &tenderV1{tenderRepo, employeeService}
+	return &tenderV1{
+		tenderRepo, invitationRepo, templateRepo, shortlistRepo, categoryService, fieldService, employeeService,
+		matcher, transactor, maxOpen,
+	}
 }
 
 // GetByID.
 func (s *tenderV1) GetByID(ctx context.Context, tenderID uuid.UUID) (*entity.Tender, error) {
+	ctx, span := tracer.Start(ctx, "tenderV1.GetByID")
+	defer span.End()
+
 	tender, err := s.tenderRepo.GetByID(ctx, tenderID)
 	if err != nil {
 		if errors.Is(err, repo.ErrNoRows) {
@@ -33,6 +50,37 @@ func (s *tenderV1) GetByID(ctx context.Context, tenderID uuid.UUID) (*entity.Ten
 	return tender, nil
 }
 
+// HasInvitation.
+func (s *tenderV1) HasInvitation(ctx context.Context,
+	tenderID uuid.UUID, userID uuid.UUID, organizationID *uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "tenderV1.HasInvitation")
+	defer span.End()
+
+	err := s.invitationRepo.HasByInvitee(ctx, tenderID, &userID, organizationID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNoRows) {
+			return ErrTenderNotInvited
+		}
+		return NewTypedError("invitationRepo.HasByInvitee", ErrorTypeInternal, err)
+	}
+	return nil
+}
+
+// HasUserInvitation checks user is invited directly or by organization which user is responsible for.
+func (s *tenderV1) HasUserInvitation(ctx context.Context, tenderID uuid.UUID, userID uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "tenderV1.HasUserInvitation")
+	defer span.End()
+
+	err := s.invitationRepo.HasByUser(ctx, tenderID, userID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNoRows) {
+			return ErrTenderNotInvited
+		}
+		return NewTypedError("invitationRepo.HasByUser", ErrorTypeInternal, err)
+	}
+	return nil
+}
+
 func (s *tenderV1) getLimit(limit int) (int, error) {
 	if limit < 0 || limit > TenderLimitMax {
 		return 0, ErrTenderLimit
@@ -47,7 +95,10 @@ func (s *tenderV1) getLimit(limit int) (int, error) {
 
 // GetByServiceType.
 func (s *tenderV1) GetByServiceType(ctx context.Context,
-	serviceTypes []entity.TenderServiceType, limit int, offset int) ([]entity.Tender, error) {
+	filter entity.TenderFilter, limit int, offset int) ([]entity.Tender, error) {
+	ctx, span := tracer.Start(ctx, "tenderV1.GetByServiceType")
+	defer span.End()
+
 	// Validate limit.
 	limit, err := s.getLimit(limit)
 	if err != nil {
@@ -59,16 +110,23 @@ func (s *tenderV1) GetByServiceType(ctx context.Context,
 		return nil, ErrTenderOffset
 	}
 
-	// Validate tender service type.
-	for _, serviceType := range serviceTypes {
-		err = serviceType.Validate()
-		if err != nil {
-			return nil, NewTypedError("tender service types is invalid", ErrorTypeInvalid, err)
-		}
+	// Validate tender filter.
+	if err = filter.Validate(); err != nil {
+		return nil, NewTypedError("tender filter is invalid", ErrorTypeInvalid, err)
+	}
+
+	// Normalize tags to stored form.
+	if filter.Tags, err = entity.NormalizeTenderTags(filter.Tags); err != nil {
+		return nil, NewTypedError("tender filter is invalid", ErrorTypeInvalid, err)
 	}
 
-	// Get published tenders by service type.
-	tenders, err := s.tenderRepo.GetByServiceType(ctx, serviceTypes, limit, offset)
+	// Verify tender service types are known categories.
+	if err = s.categoryService.Verify(ctx, filter.ServiceTypes...); err != nil {
+		return nil, err
+	}
+
+	// Get published tenders by filter.
+	tenders, err := s.tenderRepo.GetByServiceType(ctx, filter, limit, offset)
 	if err != nil {
 		return nil, NewTypedError("tenderRepo.GetByServiceType", ErrorTypeInternal, err)
 	}
@@ -76,36 +134,136 @@ func (s *tenderV1) GetByServiceType(ctx context.Context,
 	return tenders, nil
 }
 
+// GetTags.
+func (s *tenderV1) GetTags(ctx context.Context, limit int, offset int) ([]entity.TenderTag, error) {
+	ctx, span := tracer.Start(ctx, "tenderV1.GetTags")
+	defer span.End()
+
+	// Validate limit.
+	limit, err := s.getLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate offset.
+	if offset < 0 {
+		return nil, ErrTenderOffset
+	}
+
+	// Get tags of published tenders.
+	tags, err := s.tenderRepo.GetTags(ctx, limit, offset)
+	if err != nil {
+		return nil, NewTypedError("tenderRepo.GetTags", ErrorTypeInternal, err)
+	}
+
+	return tags, nil
+}
+
 // Create.
 func (s *tenderV1) Create(ctx context.Context, username string, tender entity.Tender) (*entity.Tender, error) {
+	ctx, span := tracer.Start(ctx, "tenderV1.Create")
+	defer span.End()
+
+	// Set default tender visibility.
+	if tender.Visibility == "" {
+		tender.Visibility = entity.TenderPublic
+	}
+
 	// Validate tender data.
 	err := tender.Validate()
 	if err != nil {
 		return nil, NewTypedError("tender data is invalid", ErrorTypeInvalid, err)
 	}
 
+	// Normalize tender tags.
+	if tender.Tags, err = entity.NormalizeTenderTags(tender.Tags); err != nil {
+		return nil, NewTypedError("tender data is invalid", ErrorTypeInvalid, err)
+	}
+
+	// Verify tender service type is known category.
+	if err = s.categoryService.Verify(ctx, tender.ServiceType); err != nil {
+		return nil, err
+	}
+
 	// Get employee associated with organization.
 	employee, err := s.employeeService.GetEmployee(ctx, username, tender.OrganizationID)
 	if err != nil {
 		return nil, err
 	}
 
+	// Validate custom fields against organization schema.
+	fields, err := s.fieldService.GetSchema(ctx, tender.OrganizationID, entity.CustomFieldTender)
+	if err != nil {
+		return nil, err
+	}
+	if err = tender.CustomFields.Validate(fields); err != nil {
+		return nil, NewTypedError("tender custom fields are invalid", ErrorTypeInvalid, err)
+	}
+
 	// Set tender initial values.
 	tender.Version = 1
+	tender.Round = 1
 	tender.CreatorID = employee.ID
 
-	// Create tender.
-	createdTender, err := s.tenderRepo.Create(ctx, tender)
-	if err != nil {
-		return nil, NewTypedError("tenderRepo.Create", ErrorTypeInternal, err)
+	// Create tender, verifying organization open tenders quota unless it is closed.
+	create := func(ctx context.Context) (*entity.Tender, error) {
+		createdTender, err := s.tenderRepo.Create(ctx, tender)
+		if err != nil {
+			return nil, NewTypedError("tenderRepo.Create", ErrorTypeInternal, err)
+		}
+		return createdTender, nil
 	}
+	if tender.Status != entity.TenderClosed {
+		return s.withOpenQuota(ctx, tender.OrganizationID, create)
+	}
+	return create(ctx)
+}
 
-	return createdTender, nil
+// withOpenQuota runs fn in transaction, which is rolled back if tender returned by fn is not closed
+// and organization has already reached max number of not closed tenders.
+// Transactions are serialized per organization, so concurrent calls cannot exceed quota.
+func (s *tenderV1) withOpenQuota(ctx context.Context, organizationID uuid.UUID,
+	fn func(ctx context.Context) (*entity.Tender, error)) (*entity.Tender, error) {
+	if s.maxOpen == 0 {
+		return fn(ctx)
+	}
+
+	var tender *entity.Tender
+	err := s.transactor.InTx(ctx, func(ctx context.Context) error {
+		if err := s.tenderRepo.LockOrganization(ctx, organizationID); err != nil {
+			return NewTypedError("tenderRepo.LockOrganization", ErrorTypeInternal, err)
+		}
+
+		count, err := s.tenderRepo.CountOpen(ctx, organizationID)
+		if err != nil {
+			return NewTypedError("tenderRepo.CountOpen", ErrorTypeInternal, err)
+		}
+
+		tender, err = fn(ctx)
+		if err != nil {
+			return err
+		}
+		if tender.Status != entity.TenderClosed && count >= s.maxOpen {
+			return ErrTenderOpenQuota
+		}
+		return nil
+	})
+	if err != nil {
+		var serviceErr *Error
+		if !errors.As(err, &serviceErr) {
+			return nil, NewTypedError("transactor.InTx", ErrorTypeInternal, err)
+		}
+		return nil, err
+	}
+	return tender, nil
 }
 
 // GetByCreatorUsername.
 func (s *tenderV1) GetByCreatorUsername(ctx context.Context,
 	username string, limit int, offset int) ([]entity.Tender, error) {
+	ctx, span := tracer.Start(ctx, "tenderV1.GetByCreatorUsername")
+	defer span.End()
+
 	// Validate limit.
 	limit, err := s.getLimit(limit)
 	if err != nil {
@@ -132,26 +290,59 @@ func (s *tenderV1) GetByCreatorUsername(ctx context.Context,
 	return tenders, nil
 }
 
-// GetStatus.
-func (s *tenderV1) GetStatus(ctx context.Context, username string, tenderID uuid.UUID) (*entity.TenderStatus, error) {
-	// Verify user not associated with organization.
-	_, err := s.employeeService.GetUser(ctx, username)
+// GetByInviteeUsername.
+func (s *tenderV1) GetByInviteeUsername(ctx context.Context,
+	username string, limit int, offset int) ([]entity.Tender, error) {
+	ctx, span := tracer.Start(ctx, "tenderV1.GetByInviteeUsername")
+	defer span.End()
+
+	// Validate limit.
+	limit, err := s.getLimit(limit)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get tender by id.
-	tender, err := s.GetByID(ctx, tenderID)
+	// Validate offset.
+	if offset < 0 {
+		return nil, ErrTenderOffset
+	}
+
+	// Get user by username.
+	user, err := s.employeeService.GetUser(ctx, username)
 	if err != nil {
 		return nil, err
 	}
 
-	return &tender.Status, nil
+	// Get invite-only tenders by invitee id.
+	tenders, err := s.tenderRepo.GetByInvitee(ctx, user.ID, limit, offset)
+	if err != nil {
+		return nil, NewTypedError("tenderRepo.GetByInvitee", ErrorTypeInternal, err)
+	}
+
+	return tenders, nil
+}
+
+// GetStatus returns tender, which status is requested.
+func (s *tenderV1) GetStatus(ctx context.Context, username string, tenderID uuid.UUID) (*entity.Tender, error) {
+	ctx, span := tracer.Start(ctx, "tenderV1.GetStatus")
+	defer span.End()
+
+	// Verify user not associated with organization.
+	_, err := s.employeeService.GetUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get tender by id.
+	return s.GetByID(ctx, tenderID)
 }
 
 // UpdateStatus.
 func (s *tenderV1) UpdateStatus(ctx context.Context,
 	username string, tenderID uuid.UUID, status entity.TenderStatus) (*entity.Tender, error) {
+	ctx, span := tracer.Start(ctx, "tenderV1.UpdateStatus")
+	defer span.End()
+
 	// Validate tender status.
 	if err := status.Validate(); err != nil {
 		return nil, NewTypedError("tender status is invalid", ErrorTypeInvalid, err)
@@ -169,10 +360,26 @@ func (s *tenderV1) UpdateStatus(ctx context.Context,
 		return nil, err
 	}
 
-	// Update tender status.
-	tender, err = s.tenderRepo.UpdateStatus(ctx, tender.ID, status)
+	// Update tender status, verifying organization open tenders quota if closed tender is reopened.
+	previous := tender.Status
+	update := func(ctx context.Context) (*entity.Tender, error) {
+		updatedTender, err := s.tenderRepo.UpdateStatus(ctx, tenderID, status)
+		if err != nil {
+			return nil, NewTypedError("tenderRepo.UpdateStatus", ErrorTypeInternal, err)
+		}
+		return updatedTender, nil
+	}
+	if previous == entity.TenderClosed && status != entity.TenderClosed {
+		tender, err = s.withOpenQuota(ctx, tender.OrganizationID, update)
+	} else {
+		tender, err = update(ctx)
+	}
 	if err != nil {
-		return nil, NewTypedError("tenderRepo.UpdateStatus", ErrorTypeInternal, err)
+		return nil, err
+	}
+
+	if previous != entity.TenderPublished && tender.Status == entity.TenderPublished {
+		s.matcher.TenderChanged(ctx, entity.NotificationTenderPublished, tender)
 	}
 
 	return tender, nil
@@ -181,11 +388,30 @@ func (s *tenderV1) UpdateStatus(ctx context.Context,
 // Update.
 func (s *tenderV1) Update(ctx context.Context,
 	username string, tenderID uuid.UUID, data entity.TenderData) (*entity.Tender, error) {
+	ctx, span := tracer.Start(ctx, "tenderV1.Update")
+	defer span.End()
+
 	// Validate tender data.
 	if err := data.Validate(); err != nil {
 		return nil, NewTypedError("tender data is invalid", ErrorTypeInvalid, err)
 	}
 
+	// Normalize tender tags.
+	if data.Tags != nil {
+		tags, err := entity.NormalizeTenderTags(*data.Tags)
+		if err != nil {
+			return nil, NewTypedError("tender data is invalid", ErrorTypeInvalid, err)
+		}
+		data.Tags = &tags
+	}
+
+	// Verify tender service type is known category.
+	if data.ServiceType != nil {
+		if err := s.categoryService.Verify(ctx, *data.ServiceType); err != nil {
+			return nil, err
+		}
+	}
+
 	// Get tender by id.
 	tender, err := s.GetByID(ctx, tenderID)
 	if err != nil {
@@ -198,18 +424,46 @@ func (s *tenderV1) Update(ctx context.Context,
 		return nil, err
 	}
 
+	// Validate tender terms with applied data.
+	updated := *tender
+	data.Apply(&updated)
+	if err = updated.TenderTerms.Validate(); err != nil {
+		return nil, NewTypedError("tender data is invalid", ErrorTypeInvalid, err)
+	}
+
+	// Validate updated custom fields against organization schema.
+	if data.CustomFields != nil {
+		fields, err := s.fieldService.GetSchema(ctx, tender.OrganizationID, entity.CustomFieldTender)
+		if err != nil {
+			return nil, err
+		}
+		if err = data.CustomFields.ValidateValues(fields); err != nil {
+			return nil, NewTypedError("tender custom fields are invalid", ErrorTypeInvalid, err)
+		}
+		if err = updated.CustomFields.ValidateRequired(fields); err != nil {
+			return nil, NewTypedError("tender custom fields are invalid", ErrorTypeInvalid, err)
+		}
+	}
+
 	// Update tender data.
 	tender, err = s.tenderRepo.Update(ctx, tender.ID, data)
 	if err != nil {
 		return nil, NewTypedError("tenderRepo.Update", ErrorTypeInternal, err)
 	}
 
+	if tender.Status == entity.TenderPublished {
+		s.matcher.TenderChanged(ctx, entity.NotificationTenderUpdated, tender)
+	}
+
 	return tender, nil
 }
 
 // Rollback.
 func (s *tenderV1) Rollback(ctx context.Context,
 	username string, tenderID uuid.UUID, version int) (*entity.Tender, error) {
+	ctx, span := tracer.Start(ctx, "tenderV1.Rollback")
+	defer span.End()
+
 	// Validate tender version.
 	if version < 1 {
 		return nil, ErrTenderVersion
@@ -227,14 +481,369 @@ func (s *tenderV1) Rollback(ctx context.Context,
 		return nil, err
 	}
 
-	// Rollback tender by id and version.
-	tender, err = s.tenderRepo.Rollback(ctx, tender.ID, version)
+	// Rollback tender by id and version, verifying organization open tenders quota if closed tender
+	// is reopened by status of restored version.
+	rollback := func(ctx context.Context) (*entity.Tender, error) {
+		rolledBackTender, err := s.tenderRepo.Rollback(ctx, tenderID, version)
+		if err != nil {
+			if errors.Is(err, repo.ErrNoRows) {
+				return nil, ErrTenderVersionNotExist
+			}
+			return nil, NewTypedError("tenderRepo.Rollback", ErrorTypeInternal, err)
+		}
+		return rolledBackTender, nil
+	}
+	if tender.Status == entity.TenderClosed {
+		tender, err = s.withOpenQuota(ctx, tender.OrganizationID, rollback)
+	} else {
+		tender, err = rollback(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if tender.Status == entity.TenderPublished {
+		s.matcher.TenderChanged(ctx, entity.NotificationTenderUpdated, tender)
+	}
+
+	return tender, nil
+}
+
+// NextRound.
+func (s *tenderV1) NextRound(ctx context.Context, username string, tenderID uuid.UUID) (*entity.Tender, error) {
+	ctx, span := tracer.Start(ctx, "tenderV1.NextRound")
+	defer span.End()
+
+	// Get tender by id.
+	tender, err := s.GetByID(ctx, tenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify employee associated with organization.
+	_, err = s.employeeService.GetEmployee(ctx, username, tender.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify tender status.
+	if tender.Status != entity.TenderPublished {
+		return nil, ErrTenderNotPublished
+	}
+
+	// Verify current round has shortlisted bids.
+	shortlist, err := s.shortlistRepo.GetByTenderID(ctx, tender.ID, tender.Round)
+	if err != nil {
+		return nil, NewTypedError("shortlistRepo.GetByTenderID", ErrorTypeInternal, err)
+	}
+	if len(shortlist) == 0 {
+		return nil, ErrTenderNoShortlist
+	}
+
+	// Update tender round.
+	tender, err = s.tenderRepo.UpdateRound(ctx, tender.ID, tender.Round+1)
+	if err != nil {
+		return nil, NewTypedError("tenderRepo.UpdateRound", ErrorTypeInternal, err)
+	}
+
+	return tender, nil
+}
+
+// CreateInvitation.
+func (s *tenderV1) CreateInvitation(ctx context.Context, username string, tenderID uuid.UUID,
+	organizationID *uuid.UUID, inviteeUsername *string) (*entity.TenderInvitation, error) {
+	ctx, span := tracer.Start(ctx, "tenderV1.CreateInvitation")
+	defer span.End()
+
+	// Get tender by id.
+	tender, err := s.GetByID(ctx, tenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify employee associated with organization.
+	employee, err := s.employeeService.GetEmployee(ctx, username, tender.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set invitation.
+	invitation := entity.TenderInvitation{
+		TenderID:       tender.ID,
+		OrganizationID: organizationID,
+		CreatorID:      employee.ID,
+	}
+
+	// Get invited user by username.
+	if inviteeUsername != nil {
+		invitee, err := s.employeeService.GetUser(ctx, *inviteeUsername)
+		if err != nil {
+			if errors.Is(err, ErrEmployeeUnauthorized) {
+				return nil, ErrTenderInviteeNotExist
+			}
+			return nil, err
+		}
+		invitation.UserID = &invitee.ID
+	}
+
+	// Validate invitation data.
+	if err = invitation.Validate(); err != nil {
+		return nil, NewTypedError("tender invitation data is invalid", ErrorTypeInvalid, err)
+	}
+
+	// Verify invitation does not exist.
+	err = s.invitationRepo.HasByInvitee(ctx, tender.ID, invitation.UserID, invitation.OrganizationID)
+	if err == nil {
+		return nil, ErrTenderInvitationExist
+	}
+	if !errors.Is(err, repo.ErrNoRows) {
+		return nil, NewTypedError("invitationRepo.HasByInvitee", ErrorTypeInternal, err)
+	}
+
+	// Create invitation, which is not created if organization does not exist or same invitation
+	// has been created concurrently.
+	createdInvitation, err := s.invitationRepo.Create(ctx, invitation)
+	if err != nil {
+		if !errors.Is(err, repo.ErrNoRows) {
+			return nil, NewTypedError("invitationRepo.Create", ErrorTypeInternal, err)
+		}
+		err = s.invitationRepo.HasByInvitee(ctx, tender.ID, invitation.UserID, invitation.OrganizationID)
+		if err == nil {
+			return nil, ErrTenderInvitationExist
+		}
+		if !errors.Is(err, repo.ErrNoRows) {
+			return nil, NewTypedError("invitationRepo.HasByInvitee", ErrorTypeInternal, err)
+		}
+		return nil, ErrTenderInviteeNotExist
+	}
+
+	return createdInvitation, nil
+}
+
+// GetInvitations.
+func (s *tenderV1) GetInvitations(ctx context.Context,
+	username string, tenderID uuid.UUID, limit int, offset int) ([]entity.TenderInvitation, error) {
+	ctx, span := tracer.Start(ctx, "tenderV1.GetInvitations")
+	defer span.End()
+
+	// Validate limit.
+	limit, err := s.getLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate offset.
+	if offset < 0 {
+		return nil, ErrTenderOffset
+	}
+
+	// Get tender by id.
+	tender, err := s.GetByID(ctx, tenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify employee associated with organization.
+	_, err = s.employeeService.GetEmployee(ctx, username, tender.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get invitations by tender id.
+	invitations, err := s.invitationRepo.GetByTenderID(ctx, tender.ID, limit, offset)
+	if err != nil {
+		return nil, NewTypedError("invitationRepo.GetByTenderID", ErrorTypeInternal, err)
+	}
+
+	return invitations, nil
+}
+
+// DeleteInvitation.
+func (s *tenderV1) DeleteInvitation(ctx context.Context,
+	username string, tenderID uuid.UUID, invitationID uuid.UUID) (*entity.TenderInvitation, error) {
+	ctx, span := tracer.Start(ctx, "tenderV1.DeleteInvitation")
+	defer span.End()
+
+	// Get tender by id.
+	tender, err := s.GetByID(ctx, tenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify employee associated with organization.
+	_, err = s.employeeService.GetEmployee(ctx, username, tender.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Delete invitation.
+	invitation, err := s.invitationRepo.Delete(ctx, tender.ID, invitationID)
 	if err != nil {
 		if errors.Is(err, repo.ErrNoRows) {
-			return nil, ErrTenderVersionNotExist
+			return nil, ErrTenderInvitationNotExist
 		}
-		return nil, NewTypedError("tenderRepo.Rollback", ErrorTypeInternal, err)
+		return nil, NewTypedError("invitationRepo.Delete", ErrorTypeInternal, err)
 	}
 
-	return tender, nil
+	return invitation, nil
+}
+
+// Clone.
+func (s *tenderV1) Clone(ctx context.Context, username string, tenderID uuid.UUID) (*entity.Tender, error) {
+	ctx, span := tracer.Start(ctx, "tenderV1.Clone")
+	defer span.End()
+
+	// Get tender by id.
+	source, err := s.GetByID(ctx, tenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep custom fields still defined by organization schema.
+	fields, err := s.fieldService.GetSchema(ctx, source.OrganizationID, entity.CustomFieldTender)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create tender with source data.
+	return s.Create(ctx, username, entity.Tender{
+		Name:           source.Name,
+		Description:    source.Description,
+		ServiceType:    source.ServiceType,
+		Status:         entity.TenderCreated,
+		OrganizationID: source.OrganizationID,
+		Visibility:     source.Visibility,
+		SourceID:       &source.ID,
+		TenderTerms:    source.TenderTerms,
+		CustomFields:   source.CustomFields.Defined(fields),
+		Tags:           source.Tags,
+	})
+}
+
+func (s *tenderV1) getTemplateByID(ctx context.Context, templateID uuid.UUID) (*entity.TenderTemplate, error) {
+	template, err := s.templateRepo.GetByID(ctx, templateID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNoRows) {
+			return nil, ErrTenderTemplateNotExist
+		}
+		return nil, NewTypedError("templateRepo.GetByID", ErrorTypeInternal, err)
+	}
+	return template, nil
+}
+
+// CreateTemplate.
+func (s *tenderV1) CreateTemplate(ctx context.Context,
+	username string, tenderID uuid.UUID) (*entity.TenderTemplate, error) {
+	ctx, span := tracer.Start(ctx, "tenderV1.CreateTemplate")
+	defer span.End()
+
+	// Get tender by id.
+	tender, err := s.GetByID(ctx, tenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify employee associated with organization.
+	employee, err := s.employeeService.GetEmployee(ctx, username, tender.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create template with tender data.
+	var template entity.TenderTemplate
+	template.FromTender(tender)
+	template.CreatorID = employee.ID
+
+	createdTemplate, err := s.templateRepo.Create(ctx, template)
+	if err != nil {
+		return nil, NewTypedError("templateRepo.Create", ErrorTypeInternal, err)
+	}
+
+	return createdTemplate, nil
+}
+
+// GetTemplates.
+func (s *tenderV1) GetTemplates(ctx context.Context,
+	username string, organizationID uuid.UUID, limit int, offset int) ([]entity.TenderTemplate, error) {
+	ctx, span := tracer.Start(ctx, "tenderV1.GetTemplates")
+	defer span.End()
+
+	// Validate limit.
+	limit, err := s.getLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate offset.
+	if offset < 0 {
+		return nil, ErrTenderOffset
+	}
+
+	// Verify employee associated with organization.
+	_, err = s.employeeService.GetEmployee(ctx, username, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get templates by organization id.
+	templates, err := s.templateRepo.GetByOrganizationID(ctx, organizationID, limit, offset)
+	if err != nil {
+		return nil, NewTypedError("templateRepo.GetByOrganizationID", ErrorTypeInternal, err)
+	}
+
+	return templates, nil
+}
+
+// CreateFromTemplate.
+func (s *tenderV1) CreateFromTemplate(ctx context.Context,
+	username string, templateID uuid.UUID) (*entity.Tender, error) {
+	ctx, span := tracer.Start(ctx, "tenderV1.CreateFromTemplate")
+	defer span.End()
+
+	// Get template by id.
+	template, err := s.getTemplateByID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep custom fields still defined by organization schema.
+	fields, err := s.fieldService.GetSchema(ctx, template.OrganizationID, entity.CustomFieldTender)
+	if err != nil {
+		return nil, err
+	}
+	tender := template.ToTender()
+	tender.CustomFields = tender.CustomFields.Defined(fields)
+
+	// Create tender with template data.
+	return s.Create(ctx, username, tender)
+}
+
+// DeleteTemplate.
+func (s *tenderV1) DeleteTemplate(ctx context.Context,
+	username string, templateID uuid.UUID) (*entity.TenderTemplate, error) {
+	ctx, span := tracer.Start(ctx, "tenderV1.DeleteTemplate")
+	defer span.End()
+
+	// Get template by id.
+	template, err := s.getTemplateByID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify employee associated with organization.
+	_, err = s.employeeService.GetEmployee(ctx, username, template.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Delete template.
+	template, err = s.templateRepo.Delete(ctx, template.ID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNoRows) {
+			return nil, ErrTenderTemplateNotExist
+		}
+		return nil, NewTypedError("templateRepo.Delete", ErrorTypeInternal, err)
+	}
+
+	return template, nil
 }
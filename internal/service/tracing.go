@@ -0,0 +1,11 @@
+package service
+
+import (
+	"go.opentelemetry.io/otel"
+)
+
+const tracerName = "git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/" +
+	"zadanie-6105/internal/service"
+
+// tracer starts span for each service method using global tracer provider.
+var tracer = otel.Tracer(tracerName)
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"github.com/google/uuid"
+)
+
+const (
+	TenderWatchLimitMax     = 100
+	TenderWatchLimitDefault = 5
+)
+
+var (
+	ErrTenderWatchNotExist = NewTypedError("tender watch does not exist", ErrorTypeNotExist, nil)
+	ErrTenderWatchExist    = NewTypedError("tender watch already exists", ErrorTypeInvalid, nil)
+	ErrTenderWatchLimit    = NewTypedError(
+		fmt.Sprintf("tender watch limit must be > 0 and <= %d", TenderWatchLimitMax), ErrorTypeInvalid, nil,
+	)
+	ErrTenderWatchOffset = NewTypedError("tender watch offset must be >= 0", ErrorTypeInvalid, nil)
+
+	ErrTenderSearchNotExist = NewTypedError("tender search does not exist", ErrorTypeNotExist, nil)
+)
+
+type TenderWatch interface {
+	Create(ctx context.Context, username string, tenderID uuid.UUID) (*entity.TenderWatch, error)
+	GetByUsername(ctx context.Context, username string, limit int, offset int) ([]entity.TenderWatch, error)
+	Delete(ctx context.Context, username string, watchID uuid.UUID) (*entity.TenderWatch, error)
+
+	CreateSearch(ctx context.Context, username string, search entity.TenderSearch) (*entity.TenderSearch, error)
+	GetSearches(ctx context.Context, username string, limit int, offset int) ([]entity.TenderSearch, error)
+	DeleteSearch(ctx context.Context, username string, searchID uuid.UUID) (*entity.TenderSearch, error)
+}
+
+// TenderMatcher notifies users watching tender or having saved search matching it.
+type TenderMatcher interface {
+	// TenderChanged is called after tender is published or its published version is changed.
+	TenderChanged(ctx context.Context, event entity.NotificationEvent, tender *entity.Tender)
+	// CheckDeadlines notifies about published tenders with delivery date within window.
+	CheckDeadlines(ctx context.Context, window time.Duration) error
+}
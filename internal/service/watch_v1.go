@@ -0,0 +1,324 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/repo"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/logctx"
+	"github.com/google/uuid"
+)
+
+type tenderWatchV1 struct {
+	watchRepo       repo.TenderWatch
+	searchRepo      repo.TenderSearch
+	tenderService   Tender
+	categoryService ServiceCategory
+	employeeService Employee
+}
+
+func NewTenderWatchV1(watchRepo repo.TenderWatch, searchRepo repo.TenderSearch,
+	tenderService Tender, categoryService ServiceCategory, employeeService Employee) TenderWatch {
+	if watchRepo == nil || searchRepo == nil || tenderService == nil || categoryService == nil ||
+		employeeService == nil {
+		return nil
+	}
+	return &tenderWatchV1{watchRepo, searchRepo, tenderService, categoryService, employeeService}
+}
+
+// Create.
+func (s *tenderWatchV1) Create(ctx context.Context, username string, tenderID uuid.UUID) (*entity.TenderWatch, error) {
+	ctx, span := tracer.Start(ctx, "tenderWatchV1.Create")
+	defer span.End()
+
+	// Get user by username.
+	user, err := s.employeeService.GetUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get tender by id.
+	tender, err := s.tenderService.GetByID(ctx, tenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify user is employee associated with organization or tender is available to user.
+	_, err = s.employeeService.GetEmployee(ctx, username, tender.OrganizationID)
+	if err != nil {
+		if !errors.Is(err, ErrEmployeeForbidden) {
+			return nil, err
+		}
+
+		if tender.Status != entity.TenderPublished {
+			return nil, ErrTenderNotPublished
+		}
+
+		if tender.Visibility == entity.TenderInviteOnly {
+			if err = s.tenderService.HasUserInvitation(ctx, tender.ID, user.ID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Create watch.
+	watch, err := s.watchRepo.Create(ctx, entity.TenderWatch{TenderID: tender.ID, UserID: user.ID})
+	if err != nil {
+		if errors.Is(err, repo.ErrNoRows) {
+			return nil, ErrTenderWatchExist
+		}
+		return nil, NewTypedError("watchRepo.Create", ErrorTypeInternal, err)
+	}
+
+	return watch, nil
+}
+
+func (s *tenderWatchV1) getLimit(limit int) (int, error) {
+	if limit < 0 || limit > TenderWatchLimitMax {
+		return 0, ErrTenderWatchLimit
+	}
+
+	if limit == 0 {
+		return TenderWatchLimitDefault, nil
+	}
+
+	return limit, nil
+}
+
+// GetByUsername.
+func (s *tenderWatchV1) GetByUsername(ctx context.Context,
+	username string, limit int, offset int) ([]entity.TenderWatch, error) {
+	ctx, span := tracer.Start(ctx, "tenderWatchV1.GetByUsername")
+	defer span.End()
+
+	// Validate limit.
+	limit, err := s.getLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate offset.
+	if offset < 0 {
+		return nil, ErrTenderWatchOffset
+	}
+
+	// Get user by username.
+	user, err := s.employeeService.GetUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get watches by user id.
+	watches, err := s.watchRepo.GetByUserID(ctx, user.ID, limit, offset)
+	if err != nil {
+		return nil, NewTypedError("watchRepo.GetByUserID", ErrorTypeInternal, err)
+	}
+
+	return watches, nil
+}
+
+// Delete.
+func (s *tenderWatchV1) Delete(ctx context.Context, username string, watchID uuid.UUID) (*entity.TenderWatch, error) {
+	ctx, span := tracer.Start(ctx, "tenderWatchV1.Delete")
+	defer span.End()
+
+	// Get user by username.
+	user, err := s.employeeService.GetUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Delete watch.
+	watch, err := s.watchRepo.Delete(ctx, user.ID, watchID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNoRows) {
+			return nil, ErrTenderWatchNotExist
+		}
+		return nil, NewTypedError("watchRepo.Delete", ErrorTypeInternal, err)
+	}
+
+	return watch, nil
+}
+
+// CreateSearch.
+func (s *tenderWatchV1) CreateSearch(ctx context.Context,
+	username string, search entity.TenderSearch) (*entity.TenderSearch, error) {
+	ctx, span := tracer.Start(ctx, "tenderWatchV1.CreateSearch")
+	defer span.End()
+
+	// Validate search data.
+	err := search.Validate()
+	if err != nil {
+		return nil, NewTypedError("tender search data is invalid", ErrorTypeInvalid, err)
+	}
+
+	// Normalize tags to stored form.
+	if search.Tags, err = entity.NormalizeTenderTags(search.Tags); err != nil {
+		return nil, NewTypedError("tender search data is invalid", ErrorTypeInvalid, err)
+	}
+
+	// Verify search service type is known category.
+	if search.ServiceType != nil {
+		if err = s.categoryService.Verify(ctx, *search.ServiceType); err != nil {
+			return nil, err
+		}
+	}
+
+	// Get user by username.
+	user, err := s.employeeService.GetUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create search.
+	search.UserID = user.ID
+	createdSearch, err := s.searchRepo.Create(ctx, search)
+	if err != nil {
+		return nil, NewTypedError("searchRepo.Create", ErrorTypeInternal, err)
+	}
+
+	return createdSearch, nil
+}
+
+// GetSearches.
+func (s *tenderWatchV1) GetSearches(ctx context.Context,
+	username string, limit int, offset int) ([]entity.TenderSearch, error) {
+	ctx, span := tracer.Start(ctx, "tenderWatchV1.GetSearches")
+	defer span.End()
+
+	// Validate limit.
+	limit, err := s.getLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate offset.
+	if offset < 0 {
+		return nil, ErrTenderWatchOffset
+	}
+
+	// Get user by username.
+	user, err := s.employeeService.GetUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get searches by user id.
+	searches, err := s.searchRepo.GetByUserID(ctx, user.ID, limit, offset)
+	if err != nil {
+		return nil, NewTypedError("searchRepo.GetByUserID", ErrorTypeInternal, err)
+	}
+
+	return searches, nil
+}
+
+// DeleteSearch.
+func (s *tenderWatchV1) DeleteSearch(ctx context.Context,
+	username string, searchID uuid.UUID) (*entity.TenderSearch, error) {
+	ctx, span := tracer.Start(ctx, "tenderWatchV1.DeleteSearch")
+	defer span.End()
+
+	// Get user by username.
+	user, err := s.employeeService.GetUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Delete search.
+	search, err := s.searchRepo.Delete(ctx, user.ID, searchID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNoRows) {
+			return nil, ErrTenderSearchNotExist
+		}
+		return nil, NewTypedError("searchRepo.Delete", ErrorTypeInternal, err)
+	}
+
+	return search, nil
+}
+
+// tenderMatcherV1.
+type tenderMatcherV1 struct {
+	tenderRepo repo.Tender
+	watchRepo  repo.TenderWatch
+	searchRepo repo.TenderSearch
+	channel    NotificationChannel
+}
+
+func NewTenderMatcherV1(tenderRepo repo.Tender, watchRepo repo.TenderWatch, searchRepo repo.TenderSearch,
+	channel NotificationChannel) TenderMatcher {
+	if tenderRepo == nil || watchRepo == nil || searchRepo == nil || channel == nil {
+		return nil
+	}
+	return &tenderMatcherV1{tenderRepo, watchRepo, searchRepo, channel}
+}
+
+// TenderChanged.
+func (m *tenderMatcherV1) TenderChanged(ctx context.Context, event entity.NotificationEvent, tender *entity.Tender) {
+	ctx, span := tracer.Start(ctx, "tenderMatcherV1.TenderChanged")
+	defer span.End()
+
+	// Notification failure must not fail tender change.
+	if err := m.notify(ctx, event, tender); err != nil {
+		logctx.From(ctx).ErrorContext(ctx, "failed to notify tender subscribers",
+			"tender_id", tender.ID,
+			"event", event,
+			"err", err)
+	}
+}
+
+func (m *tenderMatcherV1) notify(ctx context.Context, event entity.NotificationEvent, tender *entity.Tender) error {
+	// Get users watching tender. Access to invite-only tender is checked again, since invitations can be deleted.
+	var userIDs []uuid.UUID
+	var err error
+	if tender.Visibility == entity.TenderInviteOnly {
+		userIDs, err = m.watchRepo.GetInvitedUserIDsByTenderID(ctx, tender.ID, tender.OrganizationID)
+	} else {
+		userIDs, err = m.watchRepo.GetUserIDsByTenderID(ctx, tender.ID)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Get users with matching saved searches, which only cover public tenders.
+	if tender.Visibility == entity.TenderPublic {
+		searchUserIDs, err := m.searchRepo.GetUserIDsByTender(ctx, *tender)
+		if err != nil {
+			return err
+		}
+		for _, userID := range searchUserIDs {
+			if !slices.Contains(userIDs, userID) {
+				userIDs = append(userIDs, userID)
+			}
+		}
+	}
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	return m.channel.Notify(ctx, entity.Notification{
+		TenderID:      tender.ID,
+		TenderVersion: tender.Version,
+		Event:         event,
+	}, userIDs)
+}
+
+// CheckDeadlines.
+func (m *tenderMatcherV1) CheckDeadlines(ctx context.Context, window time.Duration) error {
+	ctx, span := tracer.Start(ctx, "tenderMatcherV1.CheckDeadlines")
+	defer span.End()
+
+	tenders, err := m.tenderRepo.GetByDeliveryDate(ctx, time.Now().Add(window))
+	if err != nil {
+		return NewTypedError("tenderRepo.GetByDeliveryDate", ErrorTypeInternal, err)
+	}
+
+	for i := range tenders {
+		m.TenderChanged(ctx, entity.NotificationTenderDeadline, &tenders[i])
+	}
+
+	return nil
+}
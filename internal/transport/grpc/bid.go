@@ -0,0 +1,258 @@
+package grpc
+
+import (
+	"context"
+
+	pb "git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/api/proto/tenderservice/v1"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func fromBid(bid *entity.Bid) (*pb.Bid, error) {
+	customFields, err := customFieldsStruct(bid.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.Bid{
+		Id:           bid.ID.String(),
+		Name:         bid.Name,
+		Description:  bid.Description,
+		Status:       string(bid.Status),
+		Reason:       bid.Reason,
+		TenderId:     bid.TenderID.String(),
+		Version:      int32(bid.Version),
+		Round:        int32(bid.Round),
+		CustomFields: customFields,
+		CreatedAt:    timestamppb.New(bid.CreatedAt),
+		UpdatedAt:    timestamppb.New(bid.UpdatedAt),
+	}
+	if bid.OrganizationID != nil {
+		resp.AuthorType = string(entity.BidOrganization)
+		resp.AuthorId = bid.OrganizationID.String()
+	} else {
+		resp.AuthorType = string(entity.BidUser)
+		resp.AuthorId = bid.CreatorID.String()
+	}
+	return resp, nil
+}
+
+func fromBids(bids []entity.Bid) (*pb.ListBidsResponse, error) {
+	resp := &pb.ListBidsResponse{Bids: make([]*pb.Bid, len(bids))}
+	for i, bid := range bids {
+		var err error
+		if resp.Bids[i], err = fromBid(&bid); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func fromBidDecision(decision *entity.BidDecision) *pb.BidDecision {
+	return &pb.BidDecision{
+		Id:         decision.ID.String(),
+		Decision:   string(decision.Type),
+		AuthorId:   redactedUUID(decision.CreatorID),
+		BidVersion: int32(decision.BidVersion),
+		ResetAt:    optionalTimestamp(decision.ResetAt),
+		RevokedAt:  optionalTimestamp(decision.RevokedAt),
+		CreatedAt:  timestamppb.New(decision.CreatedAt),
+	}
+}
+
+// BidServer serves bid service by service.Bid.
+type BidServer struct {
+	pb.UnimplementedBidServiceServer
+	Service service.Bid
+}
+
+func (s BidServer) CreateBid(ctx context.Context, req *pb.CreateBidRequest) (*pb.Bid, error) {
+	tenderID, err := parseUUID("tender_id", req.TenderId)
+	if err != nil {
+		return nil, err
+	}
+	organizationID, err := parseOptionalUUID("organization_id", req.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	bid, err := s.Service.Create(ctx, Username(ctx), entity.Bid{
+		Name:           req.Name,
+		Description:    req.Description,
+		Status:         entity.BidStatus(req.Status),
+		TenderID:       tenderID,
+		OrganizationID: organizationID,
+		CustomFields:   customFieldValues(req.CustomFields),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fromBid(bid)
+}
+
+func (s BidServer) ListMyBids(ctx context.Context, req *pb.ListMyBidsRequest) (*pb.ListBidsResponse, error) {
+	bids, err := s.Service.GetByCreatorUsername(ctx, Username(ctx), int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+	return fromBids(bids)
+}
+
+func (s BidServer) ListTenderBids(ctx context.Context, req *pb.ListTenderBidsRequest) (*pb.ListBidsResponse, error) {
+	tenderID, err := parseUUID("tender_id", req.TenderId)
+	if err != nil {
+		return nil, err
+	}
+	var round *int
+	if req.Round != nil {
+		r := int(*req.Round)
+		round = &r
+	}
+
+	bids, err := s.Service.GetByTenderID(ctx, Username(ctx), tenderID, round, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+	return fromBids(bids)
+}
+
+func (s BidServer) GetBidStatus(ctx context.Context, req *pb.GetBidStatusRequest) (*pb.Bid, error) {
+	bidID, err := parseUUID("bid_id", req.BidId)
+	if err != nil {
+		return nil, err
+	}
+
+	bid, err := s.Service.GetStatus(ctx, Username(ctx), bidID)
+	if err != nil {
+		return nil, err
+	}
+	return fromBid(bid)
+}
+
+func (s BidServer) UpdateBidStatus(ctx context.Context, req *pb.UpdateBidStatusRequest) (*pb.Bid, error) {
+	bidID, err := parseUUID("bid_id", req.BidId)
+	if err != nil {
+		return nil, err
+	}
+
+	bid, err := s.Service.UpdateStatus(ctx, Username(ctx), bidID, entity.BidStatus(req.Status))
+	if err != nil {
+		return nil, err
+	}
+	return fromBid(bid)
+}
+
+func (s BidServer) EditBid(ctx context.Context, req *pb.EditBidRequest) (*pb.Bid, error) {
+	bidID, err := parseUUID("bid_id", req.BidId)
+	if err != nil {
+		return nil, err
+	}
+
+	bid, err := s.Service.Update(ctx, Username(ctx), bidID, entity.BidData{
+		Name:         req.Name,
+		Description:  req.Description,
+		CustomFields: customFieldValues(req.CustomFields),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fromBid(bid)
+}
+
+func (s BidServer) RollbackBid(ctx context.Context, req *pb.RollbackBidRequest) (*pb.Bid, error) {
+	bidID, err := parseUUID("bid_id", req.BidId)
+	if err != nil {
+		return nil, err
+	}
+
+	bid, err := s.Service.Rollback(ctx, Username(ctx), bidID, int(req.Version))
+	if err != nil {
+		return nil, err
+	}
+	return fromBid(bid)
+}
+
+func (s BidServer) ShortlistBid(ctx context.Context, req *pb.ShortlistBidRequest) (*pb.Bid, error) {
+	bidID, err := parseUUID("bid_id", req.BidId)
+	if err != nil {
+		return nil, err
+	}
+
+	bid, err := s.Service.Shortlist(ctx, Username(ctx), bidID)
+	if err != nil {
+		return nil, err
+	}
+	return fromBid(bid)
+}
+
+func (s BidServer) WithdrawBid(ctx context.Context, req *pb.WithdrawBidRequest) (*pb.Bid, error) {
+	bidID, err := parseUUID("bid_id", req.BidId)
+	if err != nil {
+		return nil, err
+	}
+
+	bid, err := s.Service.Withdraw(ctx, Username(ctx), bidID, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return fromBid(bid)
+}
+
+func (s BidServer) ResubmitBid(ctx context.Context, req *pb.ResubmitBidRequest) (*pb.Bid, error) {
+	bidID, err := parseUUID("bid_id", req.BidId)
+	if err != nil {
+		return nil, err
+	}
+
+	bid, err := s.Service.Resubmit(ctx, Username(ctx), bidID, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return fromBid(bid)
+}
+
+func (s BidServer) SubmitBidDecision(ctx context.Context, req *pb.SubmitBidDecisionRequest) (*pb.Bid, error) {
+	bidID, err := parseUUID("bid_id", req.BidId)
+	if err != nil {
+		return nil, err
+	}
+
+	bid, err := s.Service.SubmitDecision(ctx, Username(ctx), bidID, entity.BidStatus(req.Decision))
+	if err != nil {
+		return nil, err
+	}
+	return fromBid(bid)
+}
+
+func (s BidServer) RevokeBidDecision(ctx context.Context, req *pb.RevokeBidDecisionRequest) (*pb.Bid, error) {
+	bidID, err := parseUUID("bid_id", req.BidId)
+	if err != nil {
+		return nil, err
+	}
+
+	bid, err := s.Service.RevokeDecision(ctx, Username(ctx), bidID)
+	if err != nil {
+		return nil, err
+	}
+	return fromBid(bid)
+}
+
+func (s BidServer) ListBidDecisions(ctx context.Context,
+	req *pb.ListBidDecisionsRequest) (*pb.ListBidDecisionsResponse, error) {
+	bidID, err := parseUUID("bid_id", req.BidId)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions, err := s.Service.GetDecisions(ctx, Username(ctx), bidID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListBidDecisionsResponse{Decisions: make([]*pb.BidDecision, len(decisions))}
+	for i, decision := range decisions {
+		resp.Decisions[i] = fromBidDecision(&decision)
+	}
+	return resp, nil
+}
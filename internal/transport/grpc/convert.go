@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"fmt"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// parseUUID parses id of request field, error is invalid argument status.
+func parseUUID(field string, id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "%s: %s", field, err)
+	}
+	return parsed, nil
+}
+
+// parseOptionalUUID parses id of optional request field, which is nil if it is not set.
+func parseOptionalUUID(field string, id *string) (*uuid.UUID, error) {
+	if id == nil {
+		return nil, nil
+	}
+	parsed, err := parseUUID(field, *id)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+func optionalUUID(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
+
+// redactedUUID returns nil for id of redacted author.
+func redactedUUID(id uuid.UUID) *string {
+	if id == uuid.Nil {
+		return nil
+	}
+	return optionalUUID(&id)
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// customFieldsStruct returns custom field values as struct.
+// Error is internal, since values decoded from JSON are convertible.
+func customFieldsStruct(values entity.CustomFieldValues) (*structpb.Struct, error) {
+	if values == nil {
+		return nil, nil
+	}
+	s, err := structpb.NewStruct(values)
+	if err != nil {
+		return nil, fmt.Errorf("custom field values are not convertible to struct: %w", err)
+	}
+	return s, nil
+}
+
+func customFieldValues(s *structpb.Struct) entity.CustomFieldValues {
+	if s == nil {
+		return nil
+	}
+	return s.AsMap()
+}
@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/service"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/logctx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var ErrorCodes = map[service.ErrorType]codes.Code{
+	service.ErrorTypeInvalid:      codes.InvalidArgument,
+	service.ErrorTypeUnauthorized: codes.Unauthenticated,
+	service.ErrorTypeForbidden:    codes.PermissionDenied,
+	service.ErrorTypeNotExist:     codes.NotFound,
+}
+
+// ServiceErrorStatus returns status of service error, which message includes wrapped error.
+// Returns false if error is internal.
+func ServiceErrorStatus(err error) (*status.Status, bool) {
+	var serviceErr *service.Error
+	if !errors.As(err, &serviceErr) {
+		return status.New(codes.Internal, codes.Internal.String()), false
+	}
+	code, ok := ErrorCodes[serviceErr.Type()]
+	if !ok {
+		return status.New(codes.Internal, codes.Internal.String()), false
+	}
+	msg := serviceErr.Error()
+	if uerr := serviceErr.Unwrap(); uerr != nil {
+		msg += ": " + uerr.Error()
+	}
+	return status.New(code, msg), true
+}
+
+// LogError logs error with its stack by context logger, which carries request id.
+// Internal errors are logged with error level, client errors with info level.
+func LogError(ctx context.Context, code codes.Code, err error) {
+	level := slog.LevelInfo
+	if code == codes.Internal || code == codes.Unknown {
+		level = slog.LevelError
+	}
+	logctx.From(ctx).Log(ctx, level, "request error",
+		"code", code.String(), "err", service.ErrorChain(err), "stack", service.ErrorStack(err))
+}
@@ -0,0 +1,180 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"log/slog"
+	"runtime/debug"
+	"slices"
+	"strings"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/logctx"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	RequestIDMetadata     = "x-request-id"
+	UsernameMetadata      = "username"
+	AuthorizationMetadata = "authorization"
+)
+
+const requestIDLength = 128
+
+// RequestIDInterceptor accepts request id from metadata or generates one, sends it back in header
+// and sets context logger with request id.
+func RequestIDInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		requestID := metadataValue(ctx, RequestIDMetadata)
+		if requestID == "" || len(requestID) > requestIDLength ||
+			strings.ContainsFunc(requestID, func(r rune) bool { return r < '!' || r > '~' }) {
+			requestID = uuid.NewString()
+		}
+		grpc.SetHeader(ctx, metadata.Pairs(RequestIDMetadata, requestID))
+
+		ctx = logctx.With(ctx, logger.With("request_id", requestID))
+		return handler(ctx, req)
+	}
+}
+
+func LoggerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		var remoteAddr string
+		if p, ok := peer.FromContext(ctx); ok {
+			remoteAddr = p.Addr.String()
+		}
+		logctx.From(ctx).InfoContext(ctx, "grpc request",
+			"remote_addr", remoteAddr,
+			"method", info.FullMethod,
+			"code", status.Code(err).String(),
+			"time", time.Since(start))
+		return resp, err
+	}
+}
+
+const tracerName = "git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/" +
+	"zadanie-6105/internal/transport/grpc"
+
+// TracingInterceptor starts server span continuing trace from W3C trace context metadata.
+func TracingInterceptor() grpc.UnaryServerInterceptor {
+	tracer := otel.Tracer(tracerName)
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+		ctx, span := tracer.Start(ctx, strings.TrimPrefix(info.FullMethod, "/"),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("rpc.system", "grpc"),
+				attribute.String("rpc.method", info.FullMethod)))
+		defer span.End()
+
+		// Log trace id with request id to find trace of logged request.
+		if spanContext := span.SpanContext(); spanContext.IsValid() {
+			ctx = logctx.With(ctx, logctx.From(ctx).With("trace_id", spanContext.TraceID().String()))
+		}
+
+		resp, err := handler(ctx, req)
+		code := status.Code(err)
+		span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(code)))
+		if code == grpccodes.Internal || code == grpccodes.Unknown {
+			span.SetStatus(codes.Error, code.String())
+		}
+		return resp, err
+	}
+}
+
+func RecovererInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			rc := recover()
+			if rc == nil {
+				return
+			}
+			logctx.From(ctx).ErrorContext(ctx, "panic recovered", "panic", rc, "stack", string(debug.Stack()))
+			err = status.Error(grpccodes.Internal, grpccodes.Internal.String())
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// ErrorInterceptor logs errors returned by handlers and maps service errors to statuses.
+// Internal errors are not exposed to client.
+func ErrorInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+
+		// Errors of handlers, e.g. invalid ids, are statuses already.
+		if st, ok := status.FromError(err); ok {
+			LogError(ctx, st.Code(), err)
+			return resp, err
+		}
+		st, _ := ServiceErrorStatus(err)
+		LogError(ctx, st.Code(), err)
+		return resp, st.Err()
+	}
+}
+
+// AuthInterceptor authenticates client by bearer token if token is set
+// and passes username of requester from metadata to handlers.
+// Public methods do not require username.
+func AuthInterceptor(token string, public ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if token != "" {
+			bearer, ok := strings.CutPrefix(metadataValue(ctx, AuthorizationMetadata), "Bearer ")
+			if !ok || subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) != 1 {
+				return nil, status.Error(grpccodes.Unauthenticated, "invalid authorization token")
+			}
+		}
+
+		username := metadataValue(ctx, UsernameMetadata)
+		if username == "" && !slices.Contains(public, info.FullMethod) {
+			return nil, status.Error(grpccodes.Unauthenticated, "username metadata is required")
+		}
+		return handler(WithUsername(ctx, username), req)
+	}
+}
+
+// metadataValue returns first value of incoming metadata key or empty string.
+func metadataValue(ctx context.Context, key string) string {
+	if values := metadata.ValueFromIncomingContext(ctx, key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// metadataCarrier adapts incoming metadata to propagation.TextMapCarrier.
+type metadataCarrier metadata.MD
+
+var _ propagation.TextMapCarrier = metadataCarrier{}
+
+func (c metadataCarrier) Get(key string) string {
+	if values := metadata.MD(c).Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key string, value string) { metadata.MD(c).Set(key, value) }
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
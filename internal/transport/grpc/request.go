@@ -0,0 +1,18 @@
+package grpc
+
+import (
+	"context"
+)
+
+type usernameKey struct{}
+
+// WithUsername returns context carrying username of requester.
+func WithUsername(ctx context.Context, username string) context.Context {
+	return context.WithValue(ctx, usernameKey{}, username)
+}
+
+// Username returns username of requester carried by context or empty string.
+func Username(ctx context.Context) string {
+	username, _ := ctx.Value(usernameKey{}).(string)
+	return username
+}
@@ -0,0 +1,86 @@
+package grpc
+
+import (
+	"context"
+
+	pb "git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/api/proto/tenderservice/v1"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func fromBidReviews(reviews []entity.BidReview) *pb.ListBidReviewsResponse {
+	resp := &pb.ListBidReviewsResponse{Reviews: make([]*pb.BidReview, len(reviews))}
+	for i, review := range reviews {
+		resp.Reviews[i] = &pb.BidReview{
+			Id:          review.ID.String(),
+			Description: review.Description,
+			BidId:       review.BidID.String(),
+			AuthorId:    redactedUUID(review.CreatorID),
+			CreatedAt:   timestamppb.New(review.CreatedAt),
+		}
+	}
+	return resp
+}
+
+// BidReviewServer serves bid review service by service.BidReview.
+type BidReviewServer struct {
+	pb.UnimplementedBidReviewServiceServer
+	Service service.BidReview
+}
+
+func (s BidReviewServer) CreateBidReview(ctx context.Context, req *pb.CreateBidReviewRequest) (*pb.Bid, error) {
+	bidID, err := parseUUID("bid_id", req.BidId)
+	if err != nil {
+		return nil, err
+	}
+
+	bid, err := s.Service.Create(ctx, Username(ctx), bidID, req.Description)
+	if err != nil {
+		return nil, err
+	}
+	return fromBid(bid)
+}
+
+func (s BidReviewServer) ListBidCreatorReviews(ctx context.Context,
+	req *pb.ListBidCreatorReviewsRequest) (*pb.ListBidReviewsResponse, error) {
+	tenderID, err := parseUUID("tender_id", req.TenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, err := s.Service.GetByBidCreator(ctx, Username(ctx), req.CreatorUsername, tenderID,
+		int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+	return fromBidReviews(reviews), nil
+}
+
+func (s BidReviewServer) ListBidReviews(ctx context.Context,
+	req *pb.ListBidReviewsRequest) (*pb.ListBidReviewsResponse, error) {
+	bidID, err := parseUUID("bid_id", req.BidId)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, err := s.Service.GetByBid(ctx, Username(ctx), bidID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+	return fromBidReviews(reviews), nil
+}
+
+func (s BidReviewServer) ListMyBidReviews(ctx context.Context,
+	req *pb.ListMyBidReviewsRequest) (*pb.ListBidReviewsResponse, error) {
+	tenderID, err := parseOptionalUUID("tender_id", req.TenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, err := s.Service.GetByAuthor(ctx, Username(ctx), tenderID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+	return fromBidReviews(reviews), nil
+}
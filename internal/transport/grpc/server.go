@@ -0,0 +1,36 @@
+package grpc
+
+import (
+	"log/slog"
+
+	pb "git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/api/proto/tenderservice/v1"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/service"
+	"google.golang.org/grpc"
+)
+
+// NewServer returns grpc server of tender, bid and review services.
+// Clients are authenticated by token if it is set.
+func NewServer(
+	tenderService service.Tender,
+	bidService service.Bid,
+	reviewService service.BidReview,
+	token string,
+	logger *slog.Logger,
+) *grpc.Server {
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		RequestIDInterceptor(logger),
+		TracingInterceptor(),
+		LoggerInterceptor(),
+		RecovererInterceptor(),
+		ErrorInterceptor(),
+		AuthInterceptor(token,
+			pb.TenderService_ListTenders_FullMethodName,
+			pb.TenderService_ListTenderTags_FullMethodName),
+	))
+
+	pb.RegisterTenderServiceServer(server, TenderServer{Service: tenderService})
+	pb.RegisterBidServiceServer(server, BidServer{Service: bidService})
+	pb.RegisterBidReviewServiceServer(server, BidReviewServer{Service: reviewService})
+
+	return server
+}
@@ -0,0 +1,399 @@
+package grpc
+
+import (
+	"context"
+
+	pb "git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/api/proto/tenderservice/v1"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toTenderTerms(terms *pb.TenderTerms) entity.TenderTerms {
+	if terms == nil {
+		return entity.TenderTerms{}
+	}
+	return entity.TenderTerms{
+		BudgetMin:    terms.BudgetMin,
+		BudgetMax:    terms.BudgetMax,
+		Currency:     terms.Currency,
+		Region:       terms.Region,
+		Address:      terms.Address,
+		DeliveryDate: optionalTime(terms.DeliveryDate),
+	}
+}
+
+func fromTenderTerms(terms *entity.TenderTerms) *pb.TenderTerms {
+	return &pb.TenderTerms{
+		BudgetMin:    terms.BudgetMin,
+		BudgetMax:    terms.BudgetMax,
+		Currency:     terms.Currency,
+		Region:       terms.Region,
+		Address:      terms.Address,
+		DeliveryDate: optionalTimestamp(terms.DeliveryDate),
+	}
+}
+
+func fromTender(tender *entity.Tender) (*pb.Tender, error) {
+	customFields, err := customFieldsStruct(tender.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Tender{
+		Id:             tender.ID.String(),
+		Name:           tender.Name,
+		Description:    tender.Description,
+		Status:         string(tender.Status),
+		ServiceType:    string(tender.ServiceType),
+		Visibility:     string(tender.Visibility),
+		OrganizationId: tender.OrganizationID.String(),
+		Version:        int32(tender.Version),
+		Round:          int32(tender.Round),
+		SourceId:       optionalUUID(tender.SourceID),
+		TemplateId:     optionalUUID(tender.TemplateID),
+		Terms:          fromTenderTerms(&tender.TenderTerms),
+		CustomFields:   customFields,
+		Tags:           tender.Tags,
+		CreatedAt:      timestamppb.New(tender.CreatedAt),
+		UpdatedAt:      timestamppb.New(tender.UpdatedAt),
+	}, nil
+}
+
+func fromTenders(tenders []entity.Tender) (*pb.ListTendersResponse, error) {
+	resp := &pb.ListTendersResponse{Tenders: make([]*pb.Tender, len(tenders))}
+	for i, tender := range tenders {
+		var err error
+		if resp.Tenders[i], err = fromTender(&tender); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func fromTenderInvitation(invitation *entity.TenderInvitation) *pb.TenderInvitation {
+	return &pb.TenderInvitation{
+		Id:             invitation.ID.String(),
+		TenderId:       invitation.TenderID.String(),
+		OrganizationId: optionalUUID(invitation.OrganizationID),
+		UserId:         optionalUUID(invitation.UserID),
+		CreatedAt:      timestamppb.New(invitation.CreatedAt),
+	}
+}
+
+func fromTenderTemplate(template *entity.TenderTemplate) (*pb.TenderTemplate, error) {
+	customFields, err := customFieldsStruct(template.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TenderTemplate{
+		Id:             template.ID.String(),
+		Name:           template.Name,
+		Description:    template.Description,
+		ServiceType:    string(template.ServiceType),
+		Visibility:     string(template.Visibility),
+		OrganizationId: template.OrganizationID.String(),
+		Terms:          fromTenderTerms(&template.TenderTerms),
+		CustomFields:   customFields,
+		Tags:           template.Tags,
+		CreatedAt:      timestamppb.New(template.CreatedAt),
+	}, nil
+}
+
+// TenderServer serves tender service by service.Tender.
+type TenderServer struct {
+	pb.UnimplementedTenderServiceServer
+	Service service.Tender
+}
+
+func (s TenderServer) ListTenders(ctx context.Context, req *pb.ListTendersRequest) (*pb.ListTendersResponse, error) {
+	filter := entity.TenderFilter{
+		BudgetMin:    req.BudgetMin,
+		BudgetMax:    req.BudgetMax,
+		Currency:     req.Currency,
+		Regions:      req.Regions,
+		Tags:         req.Tags,
+		TagsMatchAll: req.TagsMatchAll,
+	}
+	for _, serviceType := range req.ServiceTypes {
+		filter.ServiceTypes = append(filter.ServiceTypes, entity.TenderServiceType(serviceType))
+	}
+
+	tenders, err := s.Service.GetByServiceType(ctx, filter, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+	return fromTenders(tenders)
+}
+
+func (s TenderServer) ListTenderTags(ctx context.Context,
+	req *pb.ListTenderTagsRequest) (*pb.ListTenderTagsResponse, error) {
+	tags, err := s.Service.GetTags(ctx, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListTenderTagsResponse{Tags: make([]*pb.TenderTag, len(tags))}
+	for i, tag := range tags {
+		resp.Tags[i] = &pb.TenderTag{Name: tag.Name, Count: int32(tag.Count)}
+	}
+	return resp, nil
+}
+
+func (s TenderServer) CreateTender(ctx context.Context, req *pb.CreateTenderRequest) (*pb.Tender, error) {
+	organizationID, err := parseUUID("organization_id", req.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	tender, err := s.Service.Create(ctx, Username(ctx), entity.Tender{
+		Name:           req.Name,
+		Description:    req.Description,
+		Status:         entity.TenderStatus(req.Status),
+		ServiceType:    entity.TenderServiceType(req.ServiceType),
+		Visibility:     entity.TenderVisibility(req.Visibility),
+		OrganizationID: organizationID,
+		TenderTerms:    toTenderTerms(req.Terms),
+		CustomFields:   customFieldValues(req.CustomFields),
+		Tags:           req.Tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fromTender(tender)
+}
+
+func (s TenderServer) ListMyTenders(ctx context.Context,
+	req *pb.ListMyTendersRequest) (*pb.ListTendersResponse, error) {
+	tenders, err := s.Service.GetByCreatorUsername(ctx, Username(ctx), int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+	return fromTenders(tenders)
+}
+
+func (s TenderServer) ListInvitedTenders(ctx context.Context,
+	req *pb.ListInvitedTendersRequest) (*pb.ListTendersResponse, error) {
+	tenders, err := s.Service.GetByInviteeUsername(ctx, Username(ctx), int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+	return fromTenders(tenders)
+}
+
+func (s TenderServer) GetTenderStatus(ctx context.Context, req *pb.GetTenderStatusRequest) (*pb.Tender, error) {
+	tenderID, err := parseUUID("tender_id", req.TenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	tender, err := s.Service.GetStatus(ctx, Username(ctx), tenderID)
+	if err != nil {
+		return nil, err
+	}
+	return fromTender(tender)
+}
+
+func (s TenderServer) UpdateTenderStatus(ctx context.Context, req *pb.UpdateTenderStatusRequest) (*pb.Tender, error) {
+	tenderID, err := parseUUID("tender_id", req.TenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	tender, err := s.Service.UpdateStatus(ctx, Username(ctx), tenderID, entity.TenderStatus(req.Status))
+	if err != nil {
+		return nil, err
+	}
+	return fromTender(tender)
+}
+
+func (s TenderServer) EditTender(ctx context.Context, req *pb.EditTenderRequest) (*pb.Tender, error) {
+	tenderID, err := parseUUID("tender_id", req.TenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	data := entity.TenderData{
+		Name:         req.Name,
+		Description:  req.Description,
+		ServiceType:  (*entity.TenderServiceType)(req.ServiceType),
+		Visibility:   (*entity.TenderVisibility)(req.Visibility),
+		CustomFields: customFieldValues(req.CustomFields),
+	}
+	if req.Terms != nil {
+		terms := toTenderTerms(req.Terms)
+		data.BudgetMin = terms.BudgetMin
+		data.BudgetMax = terms.BudgetMax
+		data.Currency = terms.Currency
+		data.Region = terms.Region
+		data.Address = terms.Address
+		data.DeliveryDate = terms.DeliveryDate
+	}
+	if req.Tags != nil {
+		data.Tags = &req.Tags.Tags
+	}
+
+	tender, err := s.Service.Update(ctx, Username(ctx), tenderID, data)
+	if err != nil {
+		return nil, err
+	}
+	return fromTender(tender)
+}
+
+func (s TenderServer) RollbackTender(ctx context.Context, req *pb.RollbackTenderRequest) (*pb.Tender, error) {
+	tenderID, err := parseUUID("tender_id", req.TenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	tender, err := s.Service.Rollback(ctx, Username(ctx), tenderID, int(req.Version))
+	if err != nil {
+		return nil, err
+	}
+	return fromTender(tender)
+}
+
+func (s TenderServer) NextTenderRound(ctx context.Context, req *pb.NextTenderRoundRequest) (*pb.Tender, error) {
+	tenderID, err := parseUUID("tender_id", req.TenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	tender, err := s.Service.NextRound(ctx, Username(ctx), tenderID)
+	if err != nil {
+		return nil, err
+	}
+	return fromTender(tender)
+}
+
+func (s TenderServer) CloneTender(ctx context.Context, req *pb.CloneTenderRequest) (*pb.Tender, error) {
+	tenderID, err := parseUUID("tender_id", req.TenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	tender, err := s.Service.Clone(ctx, Username(ctx), tenderID)
+	if err != nil {
+		return nil, err
+	}
+	return fromTender(tender)
+}
+
+func (s TenderServer) CreateTenderInvitation(ctx context.Context,
+	req *pb.CreateTenderInvitationRequest) (*pb.TenderInvitation, error) {
+	tenderID, err := parseUUID("tender_id", req.TenderId)
+	if err != nil {
+		return nil, err
+	}
+	organizationID, err := parseOptionalUUID("organization_id", req.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := s.Service.CreateInvitation(ctx, Username(ctx), tenderID, organizationID, req.InviteeUsername)
+	if err != nil {
+		return nil, err
+	}
+	return fromTenderInvitation(invitation), nil
+}
+
+func (s TenderServer) ListTenderInvitations(ctx context.Context,
+	req *pb.ListTenderInvitationsRequest) (*pb.ListTenderInvitationsResponse, error) {
+	tenderID, err := parseUUID("tender_id", req.TenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, err := s.Service.GetInvitations(ctx, Username(ctx), tenderID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListTenderInvitationsResponse{Invitations: make([]*pb.TenderInvitation, len(invitations))}
+	for i, invitation := range invitations {
+		resp.Invitations[i] = fromTenderInvitation(&invitation)
+	}
+	return resp, nil
+}
+
+func (s TenderServer) DeleteTenderInvitation(ctx context.Context,
+	req *pb.DeleteTenderInvitationRequest) (*pb.TenderInvitation, error) {
+	tenderID, err := parseUUID("tender_id", req.TenderId)
+	if err != nil {
+		return nil, err
+	}
+	invitationID, err := parseUUID("invitation_id", req.InvitationId)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := s.Service.DeleteInvitation(ctx, Username(ctx), tenderID, invitationID)
+	if err != nil {
+		return nil, err
+	}
+	return fromTenderInvitation(invitation), nil
+}
+
+func (s TenderServer) CreateTenderTemplate(ctx context.Context,
+	req *pb.CreateTenderTemplateRequest) (*pb.TenderTemplate, error) {
+	tenderID, err := parseUUID("tender_id", req.TenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := s.Service.CreateTemplate(ctx, Username(ctx), tenderID)
+	if err != nil {
+		return nil, err
+	}
+	return fromTenderTemplate(template)
+}
+
+func (s TenderServer) ListTenderTemplates(ctx context.Context,
+	req *pb.ListTenderTemplatesRequest) (*pb.ListTenderTemplatesResponse, error) {
+	organizationID, err := parseUUID("organization_id", req.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := s.Service.GetTemplates(ctx, Username(ctx), organizationID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListTenderTemplatesResponse{Templates: make([]*pb.TenderTemplate, len(templates))}
+	for i, template := range templates {
+		if resp.Templates[i], err = fromTenderTemplate(&template); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (s TenderServer) CreateTenderFromTemplate(ctx context.Context,
+	req *pb.CreateTenderFromTemplateRequest) (*pb.Tender, error) {
+	templateID, err := parseUUID("template_id", req.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+
+	tender, err := s.Service.CreateFromTemplate(ctx, Username(ctx), templateID)
+	if err != nil {
+		return nil, err
+	}
+	return fromTender(tender)
+}
+
+func (s TenderServer) DeleteTenderTemplate(ctx context.Context,
+	req *pb.DeleteTenderTemplateRequest) (*pb.TenderTemplate, error) {
+	templateID, err := parseUUID("template_id", req.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := s.Service.DeleteTemplate(ctx, Username(ctx), templateID)
+	if err != nil {
+		return nil, err
+	}
+	return fromTenderTemplate(template)
+}
@@ -0,0 +1,34 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/metrics"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/transport/http/handler"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/health"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// NewAdminMux returns handler of admin listener, which must not be exposed publicly.
+func NewAdminMux(health *health.Health, metrics *metrics.Metrics, logger *slog.Logger) http.Handler {
+	if health == nil || metrics == nil || logger == nil {
+		return nil
+	}
+
+	router := http.NewServeMux()
+	router.Handle("GET /metrics", promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{
+		ErrorLog: slog.NewLogLogger(logger.Handler(), slog.LevelError),
+	}))
+	router.Handle("GET /healthz", handler.Healthz{})
+	router.Handle("GET /readyz", handler.Readyz{Health: health})
+	router.Handle("GET /status", handler.HealthStatus{Health: health})
+
+	var mux http.Handler = router
+	middlewares := []Middleware{RecovererMiddleware()}
+	for _, middleware := range middlewares {
+		mux = middleware(mux)
+	}
+
+	return mux
+}
@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/service"
+	"github.com/google/uuid"
+)
+
+type BatchItemResp struct {
+	Code   int    `json:"code"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// FromErr sets code and reason of failed item.
+// Errors are logged, internal ones are not exposed to client.
+func (r *BatchItemResp) FromErr(ctx context.Context, err error) {
+	if err == nil {
+		r.Code = http.StatusOK
+		return
+	}
+
+	code, reason, ok := ServiceErrorReason(err)
+	if !ok {
+		reason = http.StatusText(code)
+	}
+	LogError(ctx, code, err)
+	r.Code = code
+	r.Reason = reason
+}
+
+type TenderStatusBatchReq struct {
+	Mode  service.BatchMode `json:"mode"`
+	Items []struct {
+		TenderID uuid.UUID           `json:"tenderId"`
+		Status   entity.TenderStatus `json:"status"`
+	} `json:"items"`
+}
+
+type TenderStatusBatchItemResp struct {
+	TenderID uuid.UUID `json:"tenderId"`
+	BatchItemResp
+	Tender *TenderResp `json:"tender,omitempty"`
+}
+
+type TenderStatusBatchResp []TenderStatusBatchItemResp
+
+func (r *TenderStatusBatchResp) FromResults(ctx context.Context, items []service.TenderStatusItem,
+	results []service.BatchResult[entity.Tender]) {
+	*r = make([]TenderStatusBatchItemResp, len(results))
+	for i, result := range results {
+		(*r)[i].TenderID = items[i].TenderID
+		(*r)[i].FromErr(ctx, result.Err)
+		if result.Value != nil {
+			(*r)[i].Tender = new(TenderResp)
+			(*r)[i].Tender.FromTender(result.Value)
+		}
+	}
+}
+
+// TenderStatusBatch
+// POST /tenders/batch/status.
+type TenderStatusBatch struct {
+	Service service.Batch
+}
+
+func (h TenderStatusBatch) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query.
+	username := r.URL.Query().Get("username")
+
+	// Parse request body.
+	var req TenderStatusBatchReq
+	d := json.NewDecoder(r.Body)
+	if err := d.Decode(&req); err != nil {
+		WriteError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	if req.Mode == "" {
+		req.Mode = service.BatchAllOrNothing
+	}
+
+	items := make([]service.TenderStatusItem, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = service.TenderStatusItem{TenderID: item.TenderID, Status: item.Status}
+	}
+
+	// Execute service method.
+	results, err := h.Service.UpdateTenderStatuses(r.Context(), username, items, req.Mode)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp TenderStatusBatchResp
+	resp.FromResults(r.Context(), items, results)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+type BidStatusBatchReq struct {
+	Mode  service.BatchMode `json:"mode"`
+	Items []struct {
+		BidID  uuid.UUID        `json:"bidId"`
+		Status entity.BidStatus `json:"status"`
+	} `json:"items"`
+}
+
+type BidStatusBatchItemResp struct {
+	BidID uuid.UUID `json:"bidId"`
+	BatchItemResp
+	Bid *BidResp `json:"bid,omitempty"`
+}
+
+type BidStatusBatchResp []BidStatusBatchItemResp
+
+func (r *BidStatusBatchResp) FromResults(ctx context.Context, items []service.BidStatusItem,
+	results []service.BatchResult[entity.Bid]) {
+	*r = make([]BidStatusBatchItemResp, len(results))
+	for i, result := range results {
+		(*r)[i].BidID = items[i].BidID
+		(*r)[i].FromErr(ctx, result.Err)
+		if result.Value != nil {
+			(*r)[i].Bid = new(BidResp)
+			(*r)[i].Bid.FromBid(result.Value)
+		}
+	}
+}
+
+// BidStatusBatch
+// POST /bids/batch/status.
+type BidStatusBatch struct {
+	Service service.Batch
+}
+
+func (h BidStatusBatch) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query.
+	username := r.URL.Query().Get("username")
+
+	// Parse request body.
+	var req BidStatusBatchReq
+	d := json.NewDecoder(r.Body)
+	if err := d.Decode(&req); err != nil {
+		WriteError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	if req.Mode == "" {
+		req.Mode = service.BatchAllOrNothing
+	}
+
+	items := make([]service.BidStatusItem, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = service.BidStatusItem{BidID: item.BidID, Status: item.Status}
+	}
+
+	// Execute service method.
+	results, err := h.Service.UpdateBidStatuses(r.Context(), username, items, req.Mode)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp BidStatusBatchResp
+	resp.FromResults(r.Context(), items, results)
+	WriteValue(w, http.StatusOK, resp)
+}
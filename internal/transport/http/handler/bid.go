@@ -13,12 +13,13 @@ import (
 )
 
 type BidReq struct {
-	Name            string           `json:"name"`
-	Description     string           `json:"description"`
-	Status          entity.BidStatus `json:"status"`
-	TenderID        uuid.UUID        `json:"tenderId"`
-	OrganizationID  *uuid.UUID       `json:"organizationId"`
-	CreatorUsername string           `json:"creatorUsername"`
+	Name            string                   `json:"name"`
+	Description     string                   `json:"description"`
+	Status          entity.BidStatus         `json:"status"`
+	TenderID        uuid.UUID                `json:"tenderId"`
+	OrganizationID  *uuid.UUID               `json:"organizationId"`
+	CreatorUsername string                   `json:"creatorUsername"`
+	CustomFields    entity.CustomFieldValues `json:"customFields"`
 }
 
 func (r BidReq) ToBid() entity.Bid {
@@ -28,23 +29,32 @@ func (r BidReq) ToBid() entity.Bid {
 		Status:         r.Status,
 		TenderID:       r.TenderID,
 		OrganizationID: r.OrganizationID,
+		CustomFields:   r.CustomFields,
 	}
 }
 
+type BidReasonReq struct {
+	Reason string `json:"reason"`
+}
+
 type BidResp struct {
-	ID         uuid.UUID            `json:"id"`
-	Name       string               `json:"name"`
-	Status     entity.BidStatus     `json:"status"`
-	AuthorType entity.BidAuthorType `json:"authorType"`
-	AuthorID   uuid.UUID            `json:"authorId"`
-	Version    int                  `json:"version"`
-	CreatedAt  time.Time            `json:"createdAt"`
+	ID           uuid.UUID                `json:"id"`
+	Name         string                   `json:"name"`
+	Status       entity.BidStatus         `json:"status"`
+	Reason       *string                  `json:"reason"`
+	AuthorType   entity.BidAuthorType     `json:"authorType"`
+	AuthorID     uuid.UUID                `json:"authorId"`
+	Version      int                      `json:"version"`
+	Round        int                      `json:"round"`
+	CustomFields entity.CustomFieldValues `json:"customFields"`
+	CreatedAt    time.Time                `json:"createdAt"`
 }
 
 func (r *BidResp) FromBid(bid *entity.Bid) {
 	r.ID = bid.ID
 	r.Name = bid.Name
 	r.Status = bid.Status
+	r.Reason = bid.Reason
 	if bid.OrganizationID != nil {
 		r.AuthorType = entity.BidOrganization
 		r.AuthorID = *bid.OrganizationID
@@ -53,6 +63,8 @@ func (r *BidResp) FromBid(bid *entity.Bid) {
 		r.AuthorID = bid.CreatorID
 	}
 	r.Version = bid.Version
+	r.Round = bid.Round
+	r.CustomFields = bid.CustomFields
 	r.CreatedAt = bid.CreatedAt
 }
 
@@ -77,14 +89,14 @@ func (h BidCreate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	d := json.NewDecoder(r.Body)
 	err := d.Decode(&req)
 	if err != nil {
-		WriteReason(w, http.StatusBadRequest, err.Error())
+		WriteError(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
 
 	// Execute service method.
 	bid, err := h.Service.Create(r.Context(), req.CreatorUsername, req.ToBid())
 	if err != nil {
-		HandleServiceError(w, err)
+		HandleServiceError(w, r, err)
 		return
 	}
 
@@ -110,7 +122,7 @@ func (h BidGetByCreator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Execute service method.
 	bids, err := h.Service.GetByCreatorUsername(r.Context(), username, limit, offset)
 	if err != nil {
-		HandleServiceError(w, err)
+		HandleServiceError(w, r, err)
 		return
 	}
 
@@ -134,14 +146,23 @@ func (h BidGetByTender) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	username := query.Get("username")
 	tenderID, err := uuid.Parse(r.PathValue("tenderId"))
 	if err != nil {
-		WriteReason(w, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
 		return
 	}
+	var round *int
+	if query.Has("round") {
+		value, err := strconv.Atoi(query.Get("round"))
+		if err != nil {
+			WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("round: %s", err))
+			return
+		}
+		round = &value
+	}
 
 	// Execute service method.
-	bids, err := h.Service.GetByTenderID(r.Context(), username, tenderID, limit, offset)
+	bids, err := h.Service.GetByTenderID(r.Context(), username, tenderID, round, limit, offset)
 	if err != nil {
-		HandleServiceError(w, err)
+		HandleServiceError(w, r, err)
 		return
 	}
 
@@ -162,19 +183,22 @@ func (h BidGetStatus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	username := r.URL.Query().Get("username")
 	bidID, err := uuid.Parse(r.PathValue("bidId"))
 	if err != nil {
-		WriteReason(w, http.StatusBadRequest, fmt.Sprintf("bidId: %s", err))
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("bidId: %s", err))
 		return
 	}
 
 	// Execute service method.
-	status, err := h.Service.GetStatus(r.Context(), username, bidID)
+	bid, err := h.Service.GetStatus(r.Context(), username, bidID)
 	if err != nil {
-		HandleServiceError(w, err)
+		HandleServiceError(w, r, err)
 		return
 	}
 
-	// Write response.
-	WriteValue(w, http.StatusOK, status)
+	// Write response, unless client has current one.
+	if WriteNotModified(w, r, VersionETag(bid.Version, bid.UpdatedAt), bid.UpdatedAt) {
+		return
+	}
+	WriteValue(w, http.StatusOK, bid.Status)
 }
 
 // BidUpdateStatus
@@ -190,14 +214,14 @@ func (h BidUpdateStatus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	status := entity.BidStatus(query.Get("status"))
 	bidID, err := uuid.Parse(r.PathValue("bidId"))
 	if err != nil {
-		WriteReason(w, http.StatusBadRequest, fmt.Sprintf("bidId: %s", err))
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("bidId: %s", err))
 		return
 	}
 
 	// Execute service method.
 	bid, err := h.Service.UpdateStatus(r.Context(), username, bidID, status)
 	if err != nil {
-		HandleServiceError(w, err)
+		HandleServiceError(w, r, err)
 		return
 	}
 
@@ -218,7 +242,7 @@ func (h BidUpdate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	username := r.URL.Query().Get("username")
 	bidID, err := uuid.Parse(r.PathValue("bidId"))
 	if err != nil {
-		WriteReason(w, http.StatusBadRequest, fmt.Sprintf("bidId: %s", err))
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("bidId: %s", err))
 		return
 	}
 
@@ -226,14 +250,14 @@ func (h BidUpdate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	var data entity.BidData
 	d := json.NewDecoder(r.Body)
 	if err = d.Decode(&data); err != nil {
-		WriteReason(w, http.StatusBadRequest, err.Error())
+		WriteError(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
 
 	// Execute service method.
 	bid, err := h.Service.Update(r.Context(), username, bidID, data)
 	if err != nil {
-		HandleServiceError(w, err)
+		HandleServiceError(w, r, err)
 		return
 	}
 
@@ -256,14 +280,42 @@ func (h BidSubmitDecision) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	decision := entity.BidStatus(query.Get("decision"))
 	bidID, err := uuid.Parse(r.PathValue("bidId"))
 	if err != nil {
-		WriteReason(w, http.StatusBadRequest, fmt.Sprintf("bidId: %s", err))
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("bidId: %s", err))
 		return
 	}
 
 	// Execute service method.
 	bid, err := h.Service.SubmitDecision(r.Context(), username, bidID, decision)
 	if err != nil {
-		HandleServiceError(w, err)
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp BidResp
+	resp.FromBid(bid)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// BidRevokeDecision
+// PUT /bids/{bidId}/revoke_decision.
+type BidRevokeDecision struct {
+	Service service.Bid
+}
+
+func (h BidRevokeDecision) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	username := r.URL.Query().Get("username")
+	bidID, err := uuid.Parse(r.PathValue("bidId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("bidId: %s", err))
+		return
+	}
+
+	// Execute service method.
+	bid, err := h.Service.RevokeDecision(r.Context(), username, bidID)
+	if err != nil {
+		HandleServiceError(w, r, err)
 		return
 	}
 
@@ -285,14 +337,78 @@ func (h BidRollback) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	version, _ := strconv.Atoi(r.PathValue("version"))
 	bidID, err := uuid.Parse(r.PathValue("bidId"))
 	if err != nil {
-		WriteReason(w, http.StatusBadRequest, fmt.Sprintf("bidId: %s", err))
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("bidId: %s", err))
 		return
 	}
 
 	// Execute service method.
 	bid, err := h.Service.Rollback(r.Context(), username, bidID, version)
 	if err != nil {
-		HandleServiceError(w, err)
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp BidResp
+	resp.FromBid(bid)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// BidShortlist
+// PUT /bids/{bidId}/shortlist.
+type BidShortlist struct {
+	Service service.Bid
+}
+
+func (h BidShortlist) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	username := r.URL.Query().Get("username")
+	bidID, err := uuid.Parse(r.PathValue("bidId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("bidId: %s", err))
+		return
+	}
+
+	// Execute service method.
+	bid, err := h.Service.Shortlist(r.Context(), username, bidID)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp BidResp
+	resp.FromBid(bid)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// BidWithdraw
+// PUT /bids/{bidId}/withdraw.
+type BidWithdraw struct {
+	Service service.Bid
+}
+
+func (h BidWithdraw) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	username := r.URL.Query().Get("username")
+	bidID, err := uuid.Parse(r.PathValue("bidId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("bidId: %s", err))
+		return
+	}
+
+	// Parse request body.
+	var req BidReasonReq
+	d := json.NewDecoder(r.Body)
+	if err = d.Decode(&req); err != nil {
+		WriteError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Execute service method.
+	bid, err := h.Service.Withdraw(r.Context(), username, bidID, req.Reason)
+	if err != nil {
+		HandleServiceError(w, r, err)
 		return
 	}
 
@@ -302,15 +418,121 @@ func (h BidRollback) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	WriteValue(w, http.StatusOK, resp)
 }
 
+// BidResubmit
+// PUT /bids/{bidId}/resubmit.
+type BidResubmit struct {
+	Service service.Bid
+}
+
+func (h BidResubmit) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	username := r.URL.Query().Get("username")
+	bidID, err := uuid.Parse(r.PathValue("bidId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("bidId: %s", err))
+		return
+	}
+
+	// Parse request body.
+	var req BidReasonReq
+	d := json.NewDecoder(r.Body)
+	if err = d.Decode(&req); err != nil {
+		WriteError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Execute service method.
+	bid, err := h.Service.Resubmit(r.Context(), username, bidID, req.Reason)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp BidResp
+	resp.FromBid(bid)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+type BidDecisionResp struct {
+	ID         uuid.UUID        `json:"id"`
+	Decision   entity.BidStatus `json:"decision"`
+	AuthorID   *uuid.UUID       `json:"authorId"`
+	BidVersion int              `json:"bidVersion"`
+	ResetAt    *time.Time       `json:"resetAt"`
+	RevokedAt  *time.Time       `json:"revokedAt"`
+	CreatedAt  time.Time        `json:"createdAt"`
+}
+
+func (r *BidDecisionResp) FromBidDecision(decision *entity.BidDecision) {
+	r.ID = decision.ID
+	r.Decision = decision.Type
+	if decision.CreatorID != uuid.Nil {
+		authorID := decision.CreatorID
+		r.AuthorID = &authorID
+	}
+	r.BidVersion = decision.BidVersion
+	r.ResetAt = decision.ResetAt
+	r.RevokedAt = decision.RevokedAt
+	r.CreatedAt = decision.CreatedAt
+}
+
+type BidDecisionsResp []BidDecisionResp
+
+func (r *BidDecisionsResp) FromBidDecisions(decisions []entity.BidDecision) {
+	*r = make([]BidDecisionResp, len(decisions))
+	for i, decision := range decisions {
+		(*r)[i].FromBidDecision(&decision)
+	}
+}
+
+// BidGetDecisions
+// GET /bids/{bidId}/decisions.
+type BidGetDecisions struct {
+	Service service.Bid
+}
+
+func (h BidGetDecisions) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	username := query.Get("username")
+	bidID, err := uuid.Parse(r.PathValue("bidId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("bidId: %s", err))
+		return
+	}
+
+	// Execute service method.
+	decisions, err := h.Service.GetDecisions(r.Context(), username, bidID, limit, offset)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp BidDecisionsResp
+	resp.FromBidDecisions(decisions)
+	WriteValue(w, http.StatusOK, resp)
+}
+
 type BidReviewResp struct {
-	ID          uuid.UUID `json:"id"`
-	Description string    `json:"description"`
-	CreatedAt   time.Time `json:"createdAt"`
+	ID          uuid.UUID  `json:"id"`
+	Description string     `json:"description"`
+	BidID       uuid.UUID  `json:"bidId"`
+	AuthorID    *uuid.UUID `json:"authorId"`
+	CreatedAt   time.Time  `json:"createdAt"`
 }
 
 func (r *BidReviewResp) FromBidReview(review *entity.BidReview) {
 	r.ID = review.ID
 	r.Description = review.Description
+	r.BidID = review.BidID
+	if review.CreatorID != uuid.Nil {
+		authorID := review.CreatorID
+		r.AuthorID = &authorID
+	}
 	r.CreatedAt = review.CreatedAt
 }
 
@@ -336,14 +558,14 @@ func (h BidReviewCreate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	username := query.Get("username")
 	bidID, err := uuid.Parse(r.PathValue("bidId"))
 	if err != nil {
-		WriteReason(w, http.StatusBadRequest, fmt.Sprintf("bidId: %s", err))
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("bidId: %s", err))
 		return
 	}
 
 	// Execute service method.
 	bid, err := h.Service.Create(r.Context(), username, bidID, description)
 	if err != nil {
-		HandleServiceError(w, err)
+		HandleServiceError(w, r, err)
 		return
 	}
 
@@ -368,14 +590,14 @@ func (h BidReviewGetByBidCreator) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	requesterUsername := query.Get("requesterUsername")
 	tenderID, err := uuid.Parse(r.PathValue("tenderId"))
 	if err != nil {
-		WriteReason(w, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
 		return
 	}
 
 	// Execute service method.
 	bid, err := h.Service.GetByBidCreator(r.Context(), requesterUsername, creatorUsername, tenderID, limit, offset)
 	if err != nil {
-		HandleServiceError(w, err)
+		HandleServiceError(w, r, err)
 		return
 	}
 
@@ -384,3 +606,89 @@ func (h BidReviewGetByBidCreator) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	resp.FromBidReviews(bid)
 	WriteValue(w, http.StatusOK, resp)
 }
+
+// BidReviewGetByBid
+// GET /bids/{bidId}/reviews.
+type BidReviewGetByBid struct {
+	Service service.BidReview
+}
+
+func (h BidReviewGetByBid) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	username := query.Get("username")
+	bidID, err := uuid.Parse(r.PathValue("bidId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("bidId: %s", err))
+		return
+	}
+
+	// Execute service method.
+	reviews, err := h.Service.GetByBid(r.Context(), username, bidID, limit, offset)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp BidReviewsResp
+	resp.FromBidReviews(reviews)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// BidReviewGet
+// GET /bids/{id}/reviews.
+//
+// Reviews by bid creator and reviews by bid share the same path,
+// so request is dispatched by presence of authorUsername query parameter.
+type BidReviewGet struct {
+	Service service.BidReview
+}
+
+func (h BidReviewGet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Has("authorUsername") {
+		r.SetPathValue("tenderId", r.PathValue("id"))
+		BidReviewGetByBidCreator(h).ServeHTTP(w, r)
+		return
+	}
+
+	r.SetPathValue("bidId", r.PathValue("id"))
+	BidReviewGetByBid(h).ServeHTTP(w, r)
+}
+
+// BidReviewGetByAuthor
+// GET /bids/reviews/my.
+type BidReviewGetByAuthor struct {
+	Service service.BidReview
+}
+
+func (h BidReviewGetByAuthor) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query.
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	username := query.Get("username")
+	var tenderID *uuid.UUID
+	if query.Has("tenderId") {
+		value, err := uuid.Parse(query.Get("tenderId"))
+		if err != nil {
+			WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
+			return
+		}
+		tenderID = &value
+	}
+
+	// Execute service method.
+	reviews, err := h.Service.GetByAuthor(r.Context(), username, tenderID, limit, offset)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp BidReviewsResp
+	resp.FromBidReviews(reviews)
+	WriteValue(w, http.StatusOK, resp)
+}
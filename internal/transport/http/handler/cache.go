@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// CacheControlPrivate allows only client to cache response, which must be revalidated.
+	CacheControlPrivate = "private, no-cache"
+	// CacheControlPublic allows shared caches to store response for short time.
+	CacheControlPublic = "public, max-age=10"
+)
+
+// VersionETag returns entity tag of versioned entity.
+// Version is not changed by updates of its status, so update time is included.
+func VersionETag(version int, updatedAt time.Time) string {
+	return fmt.Sprintf(`"%d-%x"`, version, updatedAt.UnixMicro())
+}
+
+// WriteNotModified sets validators of response and writes 304 if client has current response.
+// If-None-Match takes precedence over If-Modified-Since. Returns true if response is written.
+func WriteNotModified(w http.ResponseWriter, r *http.Request, etag string, modified time.Time) bool {
+	header := w.Header()
+	header.Set("Cache-Control", CacheControlPrivate)
+	header.Set("ETag", etag)
+	header.Set("Last-Modified", modified.UTC().Format(http.TimeFormat))
+
+	if match := r.Header.Get("If-None-Match"); match != "" {
+		if !etagMatch(match, etag) {
+			return false
+		}
+		w.WriteHeader(http.StatusNotModified)
+		return true
+	}
+
+	since, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil || modified.Truncate(time.Second).After(since) {
+		return false
+	}
+	w.WriteHeader(http.StatusNotModified)
+	return true
+}
+
+// etagMatch reports whether If-None-Match header matches entity tag by weak comparison.
+func etagMatch(match string, etag string) bool {
+	for _, tag := range strings.Split(match, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
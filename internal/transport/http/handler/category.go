@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/service"
+)
+
+type ServiceCategoryReq struct {
+	Name        entity.TenderServiceType  `json:"name"`
+	Description string                    `json:"description"`
+	ParentName  *entity.TenderServiceType `json:"parentName"`
+}
+
+func (r ServiceCategoryReq) ToServiceCategory() entity.ServiceCategory {
+	return entity.ServiceCategory{
+		Name:        r.Name,
+		Description: r.Description,
+		ParentName:  r.ParentName,
+	}
+}
+
+type ServiceCategoryResp struct {
+	Name        entity.TenderServiceType  `json:"name"`
+	Description string                    `json:"description"`
+	ParentName  *entity.TenderServiceType `json:"parentName"`
+	CreatedAt   time.Time                 `json:"createdAt"`
+}
+
+func (r *ServiceCategoryResp) FromServiceCategory(category *entity.ServiceCategory) {
+	r.Name = category.Name
+	r.Description = category.Description
+	r.ParentName = category.ParentName
+	r.CreatedAt = category.CreatedAt
+}
+
+type ServiceCategoriesResp []ServiceCategoryResp
+
+func (r *ServiceCategoriesResp) FromServiceCategories(categories []entity.ServiceCategory) {
+	*r = make([]ServiceCategoryResp, len(categories))
+	for i, category := range categories {
+		(*r)[i].FromServiceCategory(&category)
+	}
+}
+
+// ServiceCategoryGetAll
+// GET /service_categories.
+type ServiceCategoryGetAll struct {
+	Service service.ServiceCategory
+}
+
+func (h ServiceCategoryGetAll) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Execute service method.
+	categories, err := h.Service.GetAll(r.Context())
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp ServiceCategoriesResp
+	resp.FromServiceCategories(categories)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// ServiceCategoryCreate
+// POST /admin/service_categories.
+type ServiceCategoryCreate struct {
+	Service service.ServiceCategory
+}
+
+func (h ServiceCategoryCreate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query.
+	username := r.URL.Query().Get("username")
+
+	// Parse request body.
+	var req ServiceCategoryReq
+	d := json.NewDecoder(r.Body)
+	if err := d.Decode(&req); err != nil {
+		WriteError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Execute service method.
+	category, err := h.Service.Create(r.Context(), username, req.ToServiceCategory())
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp ServiceCategoryResp
+	resp.FromServiceCategory(category)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// ServiceCategoryUpdate
+// PATCH /admin/service_categories/{name}.
+type ServiceCategoryUpdate struct {
+	Service service.ServiceCategory
+}
+
+func (h ServiceCategoryUpdate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	username := r.URL.Query().Get("username")
+	name := entity.TenderServiceType(r.PathValue("name"))
+
+	// Parse request body.
+	var data entity.ServiceCategoryData
+	d := json.NewDecoder(r.Body)
+	if err := d.Decode(&data); err != nil {
+		WriteError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Execute service method.
+	category, err := h.Service.Update(r.Context(), username, name, data)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp ServiceCategoryResp
+	resp.FromServiceCategory(category)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// ServiceCategoryDelete
+// DELETE /admin/service_categories/{name}.
+type ServiceCategoryDelete struct {
+	Service service.ServiceCategory
+}
+
+func (h ServiceCategoryDelete) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	username := r.URL.Query().Get("username")
+	name := entity.TenderServiceType(r.PathValue("name"))
+
+	// Execute service method.
+	category, err := h.Service.Delete(r.Context(), username, name)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp ServiceCategoryResp
+	resp.FromServiceCategory(category)
+	WriteValue(w, http.StatusOK, resp)
+}
@@ -1,25 +1,76 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
+	"log/slog"
 	"net/http"
 	"strings"
 
 	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/service"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/logctx"
 )
 
 func WriteValue(w http.ResponseWriter, code int, v any) {
-	w.Header().Set("Content-Type", "application/json")
+	writeJSON(w, code, "application/json", v)
+}
+
+func writeJSON(w http.ResponseWriter, code int, contentType string, v any) {
+	w.Header().Set("Content-Type", contentType)
 	w.WriteHeader(code)
 	e := json.NewEncoder(w)
 	e.Encode(v)
 }
 
-func WriteReason(w http.ResponseWriter, code int, reason string) {
-	WriteValue(w, code, map[string]string{
-		"reason": reason,
+const ProblemContentType = "application/problem+json"
+
+type ErrorResp struct {
+	Reason    string `json:"reason"`
+	RequestID string `json:"requestId,omitempty"`
+}
+
+// ProblemResp is RFC 7807 problem details.
+type ProblemResp struct {
+	Type      string `json:"type"`
+	Title     string `json:"title"`
+	Status    int    `json:"status"`
+	Detail    string `json:"detail,omitempty"`
+	Instance  string `json:"instance,omitempty"`
+	RequestID string `json:"requestId,omitempty"`
+}
+
+// AcceptsProblem reports whether client opted in to problem details format.
+func AcceptsProblem(r *http.Request) bool {
+	for _, accept := range r.Header.Values("Accept") {
+		for _, mediaRange := range strings.Split(accept, ",") {
+			mediaType, _, _ := strings.Cut(mediaRange, ";")
+			if strings.EqualFold(strings.TrimSpace(mediaType), ProblemContentType) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WriteError writes error response with reason and request id.
+// Problem details are written if requested by Accept header.
+func WriteError(w http.ResponseWriter, r *http.Request, code int, reason string) {
+	requestID := RequestID(r.Context())
+	if AcceptsProblem(r) {
+		writeJSON(w, code, ProblemContentType, ProblemResp{
+			Type:      "about:blank",
+			Title:     http.StatusText(code),
+			Status:    code,
+			Detail:    reason,
+			Instance:  r.URL.Path,
+			RequestID: requestID,
+		})
+		return
+	}
+	WriteValue(w, code, ErrorResp{
+		Reason:    reason,
+		RequestID: requestID,
 	})
 }
 
@@ -30,24 +81,47 @@ var ErrorCodes = map[service.ErrorType]int{
 	service.ErrorTypeNotExist:     http.StatusNotFound,
 }
 
-func HandleServiceError(w http.ResponseWriter, err error) {
+// ServiceErrorReason returns response code and reason of service error.
+// Returns false if error is internal.
+func ServiceErrorReason(err error) (int, string, bool) {
 	var serviceErr *service.Error
-	if errors.As(err, &serviceErr) {
-		code, ok := ErrorCodes[serviceErr.Type()]
-		if ok {
-			var reason strings.Builder
-			reason.WriteString(serviceErr.Error())
-			uerr := serviceErr.Unwrap()
-			if uerr != nil {
-				reason.WriteString(": ")
-				reason.WriteString(uerr.Error())
-			}
-			WriteReason(w, code, reason.String())
-			return
-		}
-		panic(fmt.Errorf("%s: %w", serviceErr.Error(), serviceErr.Unwrap()))
+	if !errors.As(err, &serviceErr) {
+		return http.StatusInternalServerError, "", false
+	}
+
+	code, ok := ErrorCodes[serviceErr.Type()]
+	if !ok {
+		return http.StatusInternalServerError, "", false
+	}
+
+	var reason strings.Builder
+	reason.WriteString(serviceErr.Error())
+	uerr := serviceErr.Unwrap()
+	if uerr != nil {
+		reason.WriteString(": ")
+		reason.WriteString(uerr.Error())
+	}
+	return code, reason.String(), true
+}
+
+// LogError logs error with its stack by context logger, which carries request id.
+// Internal errors are logged with error level, client errors with info level.
+func LogError(ctx context.Context, code int, err error) {
+	level := slog.LevelInfo
+	if code >= http.StatusInternalServerError {
+		level = slog.LevelError
 	}
+	logctx.From(ctx).Log(ctx, level, "request error",
+		"code", code, "err", service.ErrorChain(err), "stack", service.ErrorStack(err))
+}
 
-	// Panic must be recovered by RecovererMiddleware.
-	panic(err)
+// HandleServiceError logs service error and writes its reason.
+// Internal errors are not exposed to client.
+func HandleServiceError(w http.ResponseWriter, r *http.Request, err error) {
+	code, reason, ok := ServiceErrorReason(err)
+	if !ok {
+		reason = http.StatusText(code)
+	}
+	LogError(r.Context(), code, err)
+	WriteError(w, r, code, reason)
 }
@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/service"
+	"github.com/google/uuid"
+)
+
+type CustomFieldReq struct {
+	Target        entity.CustomFieldTarget `json:"target"`
+	Name          string                   `json:"name"`
+	Type          entity.CustomFieldType   `json:"type"`
+	Required      bool                     `json:"required"`
+	AllowedValues []any                    `json:"allowedValues"`
+}
+
+func (r CustomFieldReq) ToCustomField(organizationID uuid.UUID) entity.CustomField {
+	return entity.CustomField{
+		OrganizationID: organizationID,
+		Target:         r.Target,
+		Name:           r.Name,
+		Type:           r.Type,
+		Required:       r.Required,
+		AllowedValues:  r.AllowedValues,
+	}
+}
+
+type CustomFieldResp struct {
+	ID            uuid.UUID                `json:"id"`
+	Target        entity.CustomFieldTarget `json:"target"`
+	Name          string                   `json:"name"`
+	Type          entity.CustomFieldType   `json:"type"`
+	Required      bool                     `json:"required"`
+	AllowedValues []any                    `json:"allowedValues"`
+	CreatedAt     time.Time                `json:"createdAt"`
+}
+
+func (r *CustomFieldResp) FromCustomField(field *entity.CustomField) {
+	r.ID = field.ID
+	r.Target = field.Target
+	r.Name = field.Name
+	r.Type = field.Type
+	r.Required = field.Required
+	r.AllowedValues = field.AllowedValues
+	r.CreatedAt = field.CreatedAt
+}
+
+type CustomFieldsResp []CustomFieldResp
+
+func (r *CustomFieldsResp) FromCustomFields(fields []entity.CustomField) {
+	*r = make([]CustomFieldResp, len(fields))
+	for i, field := range fields {
+		(*r)[i].FromCustomField(&field)
+	}
+}
+
+// CustomFieldCreate
+// POST /organizations/{organizationId}/custom_fields.
+type CustomFieldCreate struct {
+	Service service.CustomField
+}
+
+func (h CustomFieldCreate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	username := r.URL.Query().Get("username")
+	organizationID, err := uuid.Parse(r.PathValue("organizationId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("organizationId: %s", err))
+		return
+	}
+
+	// Parse request body.
+	var req CustomFieldReq
+	d := json.NewDecoder(r.Body)
+	if err = d.Decode(&req); err != nil {
+		WriteError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Execute service method.
+	field, err := h.Service.Create(r.Context(), username, req.ToCustomField(organizationID))
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp CustomFieldResp
+	resp.FromCustomField(field)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// CustomFieldGetByOrganization
+// GET /organizations/{organizationId}/custom_fields.
+type CustomFieldGetByOrganization struct {
+	Service service.CustomField
+}
+
+func (h CustomFieldGetByOrganization) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	query := r.URL.Query()
+	username := query.Get("username")
+	var target *entity.CustomFieldTarget
+	if query.Has("target") {
+		t := entity.CustomFieldTarget(query.Get("target"))
+		target = &t
+	}
+	organizationID, err := uuid.Parse(r.PathValue("organizationId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("organizationId: %s", err))
+		return
+	}
+
+	// Execute service method.
+	fields, err := h.Service.GetByOrganization(r.Context(), username, organizationID, target)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp CustomFieldsResp
+	resp.FromCustomFields(fields)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// CustomFieldDelete
+// DELETE /organizations/{organizationId}/custom_fields/{fieldId}.
+type CustomFieldDelete struct {
+	Service service.CustomField
+}
+
+func (h CustomFieldDelete) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	username := r.URL.Query().Get("username")
+	organizationID, err := uuid.Parse(r.PathValue("organizationId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("organizationId: %s", err))
+		return
+	}
+	fieldID, err := uuid.Parse(r.PathValue("fieldId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("fieldId: %s", err))
+		return
+	}
+
+	// Execute service method.
+	field, err := h.Service.Delete(r.Context(), username, organizationID, fieldID)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp CustomFieldResp
+	resp.FromCustomField(field)
+	WriteValue(w, http.StatusOK, resp)
+}
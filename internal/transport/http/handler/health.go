@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/health"
+)
+
+type HealthCheckResp struct {
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Error    string `json:"error,omitempty"`
+	Duration string `json:"duration"`
+}
+
+type HealthStatusResp struct {
+	Status string            `json:"status"`
+	Uptime string            `json:"uptime"`
+	Checks []HealthCheckResp `json:"checks"`
+}
+
+func (r *HealthStatusResp) FromStatus(status *health.Status) {
+	r.Status = status.Status
+	r.Uptime = status.Uptime.Round(time.Second).String()
+	r.Checks = make([]HealthCheckResp, len(status.Checks))
+	for i, check := range status.Checks {
+		r.Checks[i].Name = check.Name
+		r.Checks[i].Status = check.Status
+		if check.Err != nil {
+			r.Checks[i].Error = check.Err.Error()
+		}
+		r.Checks[i].Duration = check.Duration.Round(time.Microsecond).String()
+	}
+}
+
+// Healthz
+// GET /healthz.
+type Healthz struct {
+}
+
+func (h Healthz) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ok"))
+}
+
+// Readyz
+// GET /readyz.
+type Readyz struct {
+	Health *health.Health
+}
+
+func (h Readyz) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Check readiness.
+	status := h.Health.Check(r.Context())
+
+	// Write response.
+	if !status.Ready() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(status.Status))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(status.Status))
+}
+
+// HealthStatus
+// GET /status.
+type HealthStatus struct {
+	Health *health.Health
+}
+
+func (h HealthStatus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Check readiness.
+	status := h.Health.Check(r.Context())
+
+	// Write response.
+	code := http.StatusOK
+	if !status.Ready() {
+		code = http.StatusServiceUnavailable
+	}
+	var resp HealthStatusResp
+	resp.FromStatus(&status)
+	WriteValue(w, code, resp)
+}
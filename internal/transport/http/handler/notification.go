@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/service"
+	"github.com/google/uuid"
+)
+
+type NotificationResp struct {
+	ID            uuid.UUID                `json:"id"`
+	TenderID      uuid.UUID                `json:"tenderId"`
+	TenderVersion int                      `json:"tenderVersion"`
+	Event         entity.NotificationEvent `json:"event"`
+	ReadAt        *time.Time               `json:"readAt"`
+	CreatedAt     time.Time                `json:"createdAt"`
+}
+
+func (r *NotificationResp) FromNotification(notification *entity.Notification) {
+	r.ID = notification.ID
+	r.TenderID = notification.TenderID
+	r.TenderVersion = notification.TenderVersion
+	r.Event = notification.Event
+	r.ReadAt = notification.ReadAt
+	r.CreatedAt = notification.CreatedAt
+}
+
+type NotificationsResp []NotificationResp
+
+func (r *NotificationsResp) FromNotifications(notifications []entity.Notification) {
+	*r = make([]NotificationResp, len(notifications))
+	for i, notification := range notifications {
+		(*r)[i].FromNotification(&notification)
+	}
+}
+
+// NotificationGetByUser
+// GET /notifications.
+type NotificationGetByUser struct {
+	Service service.Notification
+}
+
+func (h NotificationGetByUser) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query.
+	query := r.URL.Query()
+	username := query.Get("username")
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	unread, _ := strconv.ParseBool(query.Get("unread"))
+
+	// Execute service method.
+	notifications, err := h.Service.GetByUsername(r.Context(), username, unread, limit, offset)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp NotificationsResp
+	resp.FromNotifications(notifications)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// NotificationMarkRead
+// PUT /notifications/{notificationId}/read.
+type NotificationMarkRead struct {
+	Service service.Notification
+}
+
+func (h NotificationMarkRead) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	username := r.URL.Query().Get("username")
+	notificationID, err := uuid.Parse(r.PathValue("notificationId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("notificationId: %s", err))
+		return
+	}
+
+	// Execute service method.
+	notification, err := h.Service.MarkRead(r.Context(), username, notificationID)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp NotificationResp
+	resp.FromNotification(notification)
+	WriteValue(w, http.StatusOK, resp)
+}
@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/service"
+	"github.com/google/uuid"
+)
+
+type TenderQuestionReq struct {
+	Description string `json:"description"`
+}
+
+type TenderQuestionResp struct {
+	ID          uuid.UUID         `json:"id"`
+	TenderID    uuid.UUID         `json:"tenderId"`
+	Description string            `json:"description"`
+	AuthorID    uuid.UUID         `json:"authorId"`
+	Answers     TenderAnswersResp `json:"answers"`
+	CreatedAt   time.Time         `json:"createdAt"`
+}
+
+func (r *TenderQuestionResp) FromTenderQuestion(question *entity.TenderQuestion) {
+	r.ID = question.ID
+	r.TenderID = question.TenderID
+	r.Description = question.Description
+	r.AuthorID = question.CreatorID
+	r.Answers = TenderAnswersResp{}
+	r.CreatedAt = question.CreatedAt
+}
+
+type TenderQuestionsResp []TenderQuestionResp
+
+func (r *TenderQuestionsResp) FromTenderQuestionThreads(threads []entity.TenderQuestionThread) {
+	*r = make([]TenderQuestionResp, len(threads))
+	for i, thread := range threads {
+		(*r)[i].FromTenderQuestion(&thread.Question)
+		(*r)[i].Answers.FromTenderAnswers(thread.Answers)
+	}
+}
+
+type TenderAnswerReq struct {
+	Description string                        `json:"description"`
+	Visibility  entity.TenderAnswerVisibility `json:"visibility"`
+}
+
+type TenderAnswerResp struct {
+	ID          uuid.UUID                     `json:"id"`
+	QuestionID  uuid.UUID                     `json:"questionId"`
+	Description string                        `json:"description"`
+	Visibility  entity.TenderAnswerVisibility `json:"visibility"`
+	CreatedAt   time.Time                     `json:"createdAt"`
+}
+
+func (r *TenderAnswerResp) FromTenderAnswer(answer *entity.TenderAnswer) {
+	r.ID = answer.ID
+	r.QuestionID = answer.QuestionID
+	r.Description = answer.Description
+	r.Visibility = answer.Visibility
+	r.CreatedAt = answer.CreatedAt
+}
+
+type TenderAnswersResp []TenderAnswerResp
+
+func (r *TenderAnswersResp) FromTenderAnswers(answers []entity.TenderAnswer) {
+	*r = make([]TenderAnswerResp, len(answers))
+	for i, answer := range answers {
+		(*r)[i].FromTenderAnswer(&answer)
+	}
+}
+
+// TenderQuestionCreate
+// POST /tenders/{tenderId}/questions.
+type TenderQuestionCreate struct {
+	Service service.TenderQuestion
+}
+
+func (h TenderQuestionCreate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	username := r.URL.Query().Get("username")
+	tenderID, err := uuid.Parse(r.PathValue("tenderId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
+		return
+	}
+
+	// Parse request body.
+	var req TenderQuestionReq
+	d := json.NewDecoder(r.Body)
+	if err = d.Decode(&req); err != nil {
+		WriteError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Execute service method.
+	question, err := h.Service.Create(r.Context(), username, tenderID, req.Description)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp TenderQuestionResp
+	resp.FromTenderQuestion(question)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// TenderQuestionGetByTender
+// GET /tenders/{tenderId}/questions.
+type TenderQuestionGetByTender struct {
+	Service service.TenderQuestion
+}
+
+func (h TenderQuestionGetByTender) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	username := query.Get("username")
+	tenderID, err := uuid.Parse(r.PathValue("tenderId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
+		return
+	}
+
+	// Execute service method.
+	threads, err := h.Service.GetByTenderID(r.Context(), username, tenderID, limit, offset)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp TenderQuestionsResp
+	resp.FromTenderQuestionThreads(threads)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// TenderQuestionAnswer
+// POST /tenders/{tenderId}/questions/{questionId}/answers.
+type TenderQuestionAnswer struct {
+	Service service.TenderQuestion
+}
+
+func (h TenderQuestionAnswer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	username := r.URL.Query().Get("username")
+	tenderID, err := uuid.Parse(r.PathValue("tenderId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
+		return
+	}
+	questionID, err := uuid.Parse(r.PathValue("questionId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("questionId: %s", err))
+		return
+	}
+
+	// Parse request body.
+	var req TenderAnswerReq
+	d := json.NewDecoder(r.Body)
+	if err = d.Decode(&req); err != nil {
+		WriteError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Execute service method.
+	answer, err := h.Service.Answer(r.Context(), username, tenderID, questionID, req.Description, req.Visibility)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp TenderAnswerResp
+	resp.FromTenderAnswer(answer)
+	WriteValue(w, http.StatusOK, resp)
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+)
+
+type requestIDKey struct{}
+
+// WithRequestID returns context carrying request id.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestID returns request id carried by context or empty string.
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
@@ -17,8 +17,50 @@ type TenderReq struct {
 	Description     string                   `json:"description"`
 	Status          entity.TenderStatus      `json:"status"`
 	ServiceType     entity.TenderServiceType `json:"serviceType"`
+	Visibility      entity.TenderVisibility  `json:"visibility"`
 	OrganizationID  uuid.UUID                `json:"organizationId"`
 	CreatorUsername string                   `json:"creatorUsername"`
+	TenderTermsReq
+	CustomFields entity.CustomFieldValues `json:"customFields"`
+	Tags         []string                 `json:"tags"`
+}
+
+type TenderTermsReq struct {
+	BudgetMin    *int64     `json:"budgetMin"`
+	BudgetMax    *int64     `json:"budgetMax"`
+	Currency     *string    `json:"currency"`
+	Region       *string    `json:"region"`
+	Address      *string    `json:"address"`
+	DeliveryDate *time.Time `json:"deliveryDate"`
+}
+
+func (r TenderTermsReq) ToTenderTerms() entity.TenderTerms {
+	return entity.TenderTerms{
+		BudgetMin:    r.BudgetMin,
+		BudgetMax:    r.BudgetMax,
+		Currency:     r.Currency,
+		Region:       r.Region,
+		Address:      r.Address,
+		DeliveryDate: r.DeliveryDate,
+	}
+}
+
+type TenderTermsResp struct {
+	BudgetMin    *int64     `json:"budgetMin"`
+	BudgetMax    *int64     `json:"budgetMax"`
+	Currency     *string    `json:"currency"`
+	Region       *string    `json:"region"`
+	Address      *string    `json:"address"`
+	DeliveryDate *time.Time `json:"deliveryDate"`
+}
+
+func (r *TenderTermsResp) FromTenderTerms(terms *entity.TenderTerms) {
+	r.BudgetMin = terms.BudgetMin
+	r.BudgetMax = terms.BudgetMax
+	r.Currency = terms.Currency
+	r.Region = terms.Region
+	r.Address = terms.Address
+	r.DeliveryDate = terms.DeliveryDate
 }
 
 func (r TenderReq) ToTender() entity.Tender {
@@ -27,7 +69,11 @@ func (r TenderReq) ToTender() entity.Tender {
 		Description:    r.Description,
 		Status:         r.Status,
 		ServiceType:    r.ServiceType,
+		Visibility:     r.Visibility,
 		OrganizationID: r.OrganizationID,
+		TenderTerms:    r.ToTenderTerms(),
+		CustomFields:   r.CustomFields,
+		Tags:           r.Tags,
 	}
 }
 
@@ -37,8 +83,15 @@ type TenderResp struct {
 	Description string                   `json:"description"`
 	Status      entity.TenderStatus      `json:"status"`
 	ServiceType entity.TenderServiceType `json:"serviceType"`
+	Visibility  entity.TenderVisibility  `json:"visibility"`
 	Version     int                      `json:"version"`
+	Round       int                      `json:"round"`
+	SourceID    *uuid.UUID               `json:"sourceId"`
+	TemplateID  *uuid.UUID               `json:"templateId"`
 	CreatedAt   time.Time                `json:"createdAt"`
+	TenderTermsResp
+	CustomFields entity.CustomFieldValues `json:"customFields"`
+	Tags         []string                 `json:"tags"`
 }
 
 func (r *TenderResp) FromTender(tender *entity.Tender) {
@@ -47,8 +100,15 @@ func (r *TenderResp) FromTender(tender *entity.Tender) {
 	r.Description = tender.Description
 	r.Status = tender.Status
 	r.ServiceType = tender.ServiceType
+	r.Visibility = tender.Visibility
 	r.Version = tender.Version
+	r.Round = tender.Round
+	r.SourceID = tender.SourceID
+	r.TemplateID = tender.TemplateID
 	r.CreatedAt = tender.CreatedAt
+	r.FromTenderTerms(&tender.TenderTerms)
+	r.CustomFields = tender.CustomFields
+	r.Tags = tender.Tags
 }
 
 type TendersResp []TenderResp
@@ -71,21 +131,91 @@ func (h TenderGetByServiceType) ServeHTTP(w http.ResponseWriter, r *http.Request
 	query := r.URL.Query()
 	limit, _ := strconv.Atoi(query.Get("limit"))
 	offset, _ := strconv.Atoi(query.Get("offset"))
-	serviceTypes := make([]entity.TenderServiceType, len(query["service_type"]))
-	for i, serviceType := range query["service_type"] {
-		serviceTypes[i] = entity.TenderServiceType(serviceType)
+	var filter entity.TenderFilter
+	for _, serviceType := range query["service_type"] {
+		filter.ServiceTypes = append(filter.ServiceTypes, entity.TenderServiceType(serviceType))
+	}
+	if query.Has("budget_min") {
+		budgetMin, err := strconv.ParseInt(query.Get("budget_min"), 10, 64)
+		if err != nil {
+			WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("budget_min: %s", err))
+			return
+		}
+		filter.BudgetMin = &budgetMin
+	}
+	if query.Has("budget_max") {
+		budgetMax, err := strconv.ParseInt(query.Get("budget_max"), 10, 64)
+		if err != nil {
+			WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("budget_max: %s", err))
+			return
+		}
+		filter.BudgetMax = &budgetMax
+	}
+	if query.Has("currency") {
+		currency := query.Get("currency")
+		filter.Currency = &currency
+	}
+	filter.Regions = query["region"]
+	filter.Tags = query["tag"]
+	switch query.Get("tag_match") {
+	case "", "any":
+	case "all":
+		filter.TagsMatchAll = true
+	default:
+		WriteError(w, r, http.StatusBadRequest, "tag_match must be one of: [any all]")
+		return
 	}
 
 	// Execute service method.
-	tenders, err := h.Service.GetByServiceType(r.Context(), serviceTypes, limit, offset)
+	tenders, err := h.Service.GetByServiceType(r.Context(), filter, limit, offset)
 	if err != nil {
-		HandleServiceError(w, err)
+		HandleServiceError(w, r, err)
 		return
 	}
 
-	// Write response.
+	// Write response, which is public.
 	var resp TendersResp
 	resp.FromTenders(tenders)
+	w.Header().Set("Cache-Control", CacheControlPublic)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+type TenderTagResp struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+type TenderTagsResp []TenderTagResp
+
+func (r *TenderTagsResp) FromTenderTags(tags []entity.TenderTag) {
+	*r = make([]TenderTagResp, len(tags))
+	for i, tag := range tags {
+		(*r)[i] = TenderTagResp{Name: tag.Name, Count: tag.Count}
+	}
+}
+
+// TenderGetTags
+// GET /tenders/tags.
+type TenderGetTags struct {
+	Service service.Tender
+}
+
+func (h TenderGetTags) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query.
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+
+	// Execute service method.
+	tags, err := h.Service.GetTags(r.Context(), limit, offset)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp TenderTagsResp
+	resp.FromTenderTags(tags)
 	WriteValue(w, http.StatusOK, resp)
 }
 
@@ -101,14 +231,14 @@ func (h TenderCreate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	d := json.NewDecoder(r.Body)
 	err := d.Decode(&req)
 	if err != nil {
-		WriteReason(w, http.StatusBadRequest, err.Error())
+		WriteError(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
 
 	// Execute service method.
 	tender, err := h.Service.Create(r.Context(), req.CreatorUsername, req.ToTender())
 	if err != nil {
-		HandleServiceError(w, err)
+		HandleServiceError(w, r, err)
 		return
 	}
 
@@ -134,7 +264,33 @@ func (h TenderGetByCreator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Execute service method.
 	tenders, err := h.Service.GetByCreatorUsername(r.Context(), username, limit, offset)
 	if err != nil {
-		HandleServiceError(w, err)
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp TendersResp
+	resp.FromTenders(tenders)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// TenderGetByInvitee
+// GET /tenders/invited.
+type TenderGetByInvitee struct {
+	Service service.Tender
+}
+
+func (h TenderGetByInvitee) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query.
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	username := query.Get("username")
+
+	// Execute service method.
+	tenders, err := h.Service.GetByInviteeUsername(r.Context(), username, limit, offset)
+	if err != nil {
+		HandleServiceError(w, r, err)
 		return
 	}
 
@@ -155,19 +311,22 @@ func (h TenderGetStatus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	username := r.URL.Query().Get("username")
 	tenderID, err := uuid.Parse(r.PathValue("tenderId"))
 	if err != nil {
-		WriteReason(w, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
 		return
 	}
 
 	// Execute service method.
-	status, err := h.Service.GetStatus(r.Context(), username, tenderID)
+	tender, err := h.Service.GetStatus(r.Context(), username, tenderID)
 	if err != nil {
-		HandleServiceError(w, err)
+		HandleServiceError(w, r, err)
 		return
 	}
 
-	// Write response.
-	WriteValue(w, http.StatusOK, status)
+	// Write response, unless client has current one.
+	if WriteNotModified(w, r, VersionETag(tender.Version, tender.UpdatedAt), tender.UpdatedAt) {
+		return
+	}
+	WriteValue(w, http.StatusOK, tender.Status)
 }
 
 // TenderUpdateStatus
@@ -183,14 +342,14 @@ func (h TenderUpdateStatus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	status := entity.TenderStatus(query.Get("status"))
 	tenderID, err := uuid.Parse(r.PathValue("tenderId"))
 	if err != nil {
-		WriteReason(w, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
 		return
 	}
 
 	// Execute service method.
 	tender, err := h.Service.UpdateStatus(r.Context(), username, tenderID, status)
 	if err != nil {
-		HandleServiceError(w, err)
+		HandleServiceError(w, r, err)
 		return
 	}
 
@@ -211,7 +370,7 @@ func (h TenderUpdate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	username := r.URL.Query().Get("username")
 	tenderID, err := uuid.Parse(r.PathValue("tenderId"))
 	if err != nil {
-		WriteReason(w, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
 		return
 	}
 
@@ -219,14 +378,14 @@ func (h TenderUpdate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	var data entity.TenderData
 	d := json.NewDecoder(r.Body)
 	if err = d.Decode(&data); err != nil {
-		WriteReason(w, http.StatusBadRequest, err.Error())
+		WriteError(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
 
 	// Execute service method.
 	tender, err := h.Service.Update(r.Context(), username, tenderID, data)
 	if err != nil {
-		HandleServiceError(w, err)
+		HandleServiceError(w, r, err)
 		return
 	}
 
@@ -248,14 +407,42 @@ func (h TenderRollback) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	version, _ := strconv.Atoi(r.PathValue("version"))
 	tenderID, err := uuid.Parse(r.PathValue("tenderId"))
 	if err != nil {
-		WriteReason(w, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
 		return
 	}
 
 	// Execute service method.
 	tender, err := h.Service.Rollback(r.Context(), username, tenderID, version)
 	if err != nil {
-		HandleServiceError(w, err)
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp TenderResp
+	resp.FromTender(tender)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// TenderNextRound
+// PUT /tenders/{tenderId}/round/next.
+type TenderNextRound struct {
+	Service service.Tender
+}
+
+func (h TenderNextRound) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	username := r.URL.Query().Get("username")
+	tenderID, err := uuid.Parse(r.PathValue("tenderId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
+		return
+	}
+
+	// Execute service method.
+	tender, err := h.Service.NextRound(r.Context(), username, tenderID)
+	if err != nil {
+		HandleServiceError(w, r, err)
 		return
 	}
 
@@ -264,3 +451,311 @@ func (h TenderRollback) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	resp.FromTender(tender)
 	WriteValue(w, http.StatusOK, resp)
 }
+
+type TenderInvitationReq struct {
+	OrganizationID *uuid.UUID `json:"organizationId"`
+	Username       *string    `json:"username"`
+}
+
+type TenderInvitationResp struct {
+	ID             uuid.UUID  `json:"id"`
+	TenderID       uuid.UUID  `json:"tenderId"`
+	OrganizationID *uuid.UUID `json:"organizationId"`
+	UserID         *uuid.UUID `json:"userId"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
+func (r *TenderInvitationResp) FromTenderInvitation(invitation *entity.TenderInvitation) {
+	r.ID = invitation.ID
+	r.TenderID = invitation.TenderID
+	r.OrganizationID = invitation.OrganizationID
+	r.UserID = invitation.UserID
+	r.CreatedAt = invitation.CreatedAt
+}
+
+type TenderInvitationsResp []TenderInvitationResp
+
+func (r *TenderInvitationsResp) FromTenderInvitations(invitations []entity.TenderInvitation) {
+	*r = make([]TenderInvitationResp, len(invitations))
+	for i, invitation := range invitations {
+		(*r)[i].FromTenderInvitation(&invitation)
+	}
+}
+
+// TenderInvitationCreate
+// POST /tenders/{tenderId}/invitations.
+type TenderInvitationCreate struct {
+	Service service.Tender
+}
+
+func (h TenderInvitationCreate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	username := r.URL.Query().Get("username")
+	tenderID, err := uuid.Parse(r.PathValue("tenderId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
+		return
+	}
+
+	// Parse request body.
+	var req TenderInvitationReq
+	d := json.NewDecoder(r.Body)
+	if err = d.Decode(&req); err != nil {
+		WriteError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Execute service method.
+	invitation, err := h.Service.CreateInvitation(r.Context(), username, tenderID, req.OrganizationID, req.Username)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp TenderInvitationResp
+	resp.FromTenderInvitation(invitation)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// TenderInvitationGetByTender
+// GET /tenders/{tenderId}/invitations.
+type TenderInvitationGetByTender struct {
+	Service service.Tender
+}
+
+func (h TenderInvitationGetByTender) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	username := query.Get("username")
+	tenderID, err := uuid.Parse(r.PathValue("tenderId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
+		return
+	}
+
+	// Execute service method.
+	invitations, err := h.Service.GetInvitations(r.Context(), username, tenderID, limit, offset)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp TenderInvitationsResp
+	resp.FromTenderInvitations(invitations)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// TenderInvitationDelete
+// DELETE /tenders/{tenderId}/invitations/{invitationId}.
+type TenderInvitationDelete struct {
+	Service service.Tender
+}
+
+func (h TenderInvitationDelete) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	username := r.URL.Query().Get("username")
+	tenderID, err := uuid.Parse(r.PathValue("tenderId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
+		return
+	}
+	invitationID, err := uuid.Parse(r.PathValue("invitationId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("invitationId: %s", err))
+		return
+	}
+
+	// Execute service method.
+	invitation, err := h.Service.DeleteInvitation(r.Context(), username, tenderID, invitationID)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp TenderInvitationResp
+	resp.FromTenderInvitation(invitation)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// TenderClone
+// POST /tenders/{tenderId}/clone.
+type TenderClone struct {
+	Service service.Tender
+}
+
+func (h TenderClone) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	username := r.URL.Query().Get("username")
+	tenderID, err := uuid.Parse(r.PathValue("tenderId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
+		return
+	}
+
+	// Execute service method.
+	tender, err := h.Service.Clone(r.Context(), username, tenderID)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp TenderResp
+	resp.FromTender(tender)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+type TenderTemplateResp struct {
+	ID             uuid.UUID                `json:"id"`
+	Name           string                   `json:"name"`
+	Description    string                   `json:"description"`
+	ServiceType    entity.TenderServiceType `json:"serviceType"`
+	Visibility     entity.TenderVisibility  `json:"visibility"`
+	OrganizationID uuid.UUID                `json:"organizationId"`
+	CreatedAt      time.Time                `json:"createdAt"`
+	TenderTermsResp
+	CustomFields entity.CustomFieldValues `json:"customFields"`
+	Tags         []string                 `json:"tags"`
+}
+
+func (r *TenderTemplateResp) FromTenderTemplate(template *entity.TenderTemplate) {
+	r.ID = template.ID
+	r.Name = template.Name
+	r.Description = template.Description
+	r.ServiceType = template.ServiceType
+	r.Visibility = template.Visibility
+	r.OrganizationID = template.OrganizationID
+	r.CreatedAt = template.CreatedAt
+	r.FromTenderTerms(&template.TenderTerms)
+	r.CustomFields = template.CustomFields
+	r.Tags = template.Tags
+}
+
+type TenderTemplatesResp []TenderTemplateResp
+
+func (r *TenderTemplatesResp) FromTenderTemplates(templates []entity.TenderTemplate) {
+	*r = make([]TenderTemplateResp, len(templates))
+	for i, template := range templates {
+		(*r)[i].FromTenderTemplate(&template)
+	}
+}
+
+// TenderTemplateCreate
+// POST /tenders/{tenderId}/template.
+type TenderTemplateCreate struct {
+	Service service.Tender
+}
+
+func (h TenderTemplateCreate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	username := r.URL.Query().Get("username")
+	tenderID, err := uuid.Parse(r.PathValue("tenderId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
+		return
+	}
+
+	// Execute service method.
+	template, err := h.Service.CreateTemplate(r.Context(), username, tenderID)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp TenderTemplateResp
+	resp.FromTenderTemplate(template)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// TenderTemplateGetByOrganization
+// GET /tenders/templates.
+type TenderTemplateGetByOrganization struct {
+	Service service.Tender
+}
+
+func (h TenderTemplateGetByOrganization) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query.
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	username := query.Get("username")
+	organizationID, err := uuid.Parse(query.Get("organizationId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("organizationId: %s", err))
+		return
+	}
+
+	// Execute service method.
+	templates, err := h.Service.GetTemplates(r.Context(), username, organizationID, limit, offset)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp TenderTemplatesResp
+	resp.FromTenderTemplates(templates)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// TenderTemplateCreateTender
+// POST /tenders/templates/{templateId}/tenders.
+type TenderTemplateCreateTender struct {
+	Service service.Tender
+}
+
+func (h TenderTemplateCreateTender) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	username := r.URL.Query().Get("username")
+	templateID, err := uuid.Parse(r.PathValue("templateId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("templateId: %s", err))
+		return
+	}
+
+	// Execute service method.
+	tender, err := h.Service.CreateFromTemplate(r.Context(), username, templateID)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp TenderResp
+	resp.FromTender(tender)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// TenderTemplateDelete
+// DELETE /tenders/templates/{templateId}.
+type TenderTemplateDelete struct {
+	Service service.Tender
+}
+
+func (h TenderTemplateDelete) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	username := r.URL.Query().Get("username")
+	templateID, err := uuid.Parse(r.PathValue("templateId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("templateId: %s", err))
+		return
+	}
+
+	// Execute service method.
+	template, err := h.Service.DeleteTemplate(r.Context(), username, templateID)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp TenderTemplateResp
+	resp.FromTenderTemplate(template)
+	WriteValue(w, http.StatusOK, resp)
+}
@@ -0,0 +1,262 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/entity"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/service"
+	"github.com/google/uuid"
+)
+
+type TenderWatchResp struct {
+	ID        uuid.UUID `json:"id"`
+	TenderID  uuid.UUID `json:"tenderId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (r *TenderWatchResp) FromTenderWatch(watch *entity.TenderWatch) {
+	r.ID = watch.ID
+	r.TenderID = watch.TenderID
+	r.CreatedAt = watch.CreatedAt
+}
+
+type TenderWatchesResp []TenderWatchResp
+
+func (r *TenderWatchesResp) FromTenderWatches(watches []entity.TenderWatch) {
+	*r = make([]TenderWatchResp, len(watches))
+	for i, watch := range watches {
+		(*r)[i].FromTenderWatch(&watch)
+	}
+}
+
+// TenderWatchCreate
+// POST /tenders/{tenderId}/watch.
+type TenderWatchCreate struct {
+	Service service.TenderWatch
+}
+
+func (h TenderWatchCreate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	username := r.URL.Query().Get("username")
+	tenderID, err := uuid.Parse(r.PathValue("tenderId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("tenderId: %s", err))
+		return
+	}
+
+	// Execute service method.
+	watch, err := h.Service.Create(r.Context(), username, tenderID)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp TenderWatchResp
+	resp.FromTenderWatch(watch)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// TenderWatchGetByUser
+// GET /watches.
+type TenderWatchGetByUser struct {
+	Service service.TenderWatch
+}
+
+func (h TenderWatchGetByUser) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query.
+	query := r.URL.Query()
+	username := query.Get("username")
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+
+	// Execute service method.
+	watches, err := h.Service.GetByUsername(r.Context(), username, limit, offset)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp TenderWatchesResp
+	resp.FromTenderWatches(watches)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// TenderWatchDelete
+// DELETE /watches/{watchId}.
+type TenderWatchDelete struct {
+	Service service.TenderWatch
+}
+
+func (h TenderWatchDelete) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	username := r.URL.Query().Get("username")
+	watchID, err := uuid.Parse(r.PathValue("watchId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("watchId: %s", err))
+		return
+	}
+
+	// Execute service method.
+	watch, err := h.Service.Delete(r.Context(), username, watchID)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp TenderWatchResp
+	resp.FromTenderWatch(watch)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+type TenderSearchReq struct {
+	Name        string                    `json:"name"`
+	ServiceType *entity.TenderServiceType `json:"serviceType"`
+	Tags        []string                  `json:"tags"`
+	TagMatch    string                    `json:"tagMatch"`
+}
+
+func (r TenderSearchReq) ToTenderSearch() (entity.TenderSearch, error) {
+	search := entity.TenderSearch{
+		Name:        r.Name,
+		ServiceType: r.ServiceType,
+		Tags:        r.Tags,
+	}
+
+	switch r.TagMatch {
+	case "", "any":
+	case "all":
+		search.TagsMatchAll = true
+	default:
+		return search, errors.New("tagMatch must be one of: [any all]")
+	}
+
+	return search, nil
+}
+
+type TenderSearchResp struct {
+	ID          uuid.UUID                 `json:"id"`
+	Name        string                    `json:"name"`
+	ServiceType *entity.TenderServiceType `json:"serviceType"`
+	Tags        []string                  `json:"tags"`
+	TagMatch    string                    `json:"tagMatch"`
+	CreatedAt   time.Time                 `json:"createdAt"`
+}
+
+func (r *TenderSearchResp) FromTenderSearch(search *entity.TenderSearch) {
+	r.ID = search.ID
+	r.Name = search.Name
+	r.ServiceType = search.ServiceType
+	r.Tags = search.Tags
+	r.TagMatch = "any"
+	if search.TagsMatchAll {
+		r.TagMatch = "all"
+	}
+	r.CreatedAt = search.CreatedAt
+}
+
+type TenderSearchesResp []TenderSearchResp
+
+func (r *TenderSearchesResp) FromTenderSearches(searches []entity.TenderSearch) {
+	*r = make([]TenderSearchResp, len(searches))
+	for i, search := range searches {
+		(*r)[i].FromTenderSearch(&search)
+	}
+}
+
+// TenderSearchCreate
+// POST /searches.
+type TenderSearchCreate struct {
+	Service service.TenderWatch
+}
+
+func (h TenderSearchCreate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query.
+	username := r.URL.Query().Get("username")
+
+	// Parse request body.
+	var req TenderSearchReq
+	d := json.NewDecoder(r.Body)
+	if err := d.Decode(&req); err != nil {
+		WriteError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	search, err := req.ToTenderSearch()
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Execute service method.
+	createdSearch, err := h.Service.CreateSearch(r.Context(), username, search)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp TenderSearchResp
+	resp.FromTenderSearch(createdSearch)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// TenderSearchGetByUser
+// GET /searches.
+type TenderSearchGetByUser struct {
+	Service service.TenderWatch
+}
+
+func (h TenderSearchGetByUser) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query.
+	query := r.URL.Query()
+	username := query.Get("username")
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+
+	// Execute service method.
+	searches, err := h.Service.GetSearches(r.Context(), username, limit, offset)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp TenderSearchesResp
+	resp.FromTenderSearches(searches)
+	WriteValue(w, http.StatusOK, resp)
+}
+
+// TenderSearchDelete
+// DELETE /searches/{searchId}.
+type TenderSearchDelete struct {
+	Service service.TenderWatch
+}
+
+func (h TenderSearchDelete) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse request query and path.
+	username := r.URL.Query().Get("username")
+	searchID, err := uuid.Parse(r.PathValue("searchId"))
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, fmt.Sprintf("searchId: %s", err))
+		return
+	}
+
+	// Execute service method.
+	search, err := h.Service.DeleteSearch(r.Context(), username, searchID)
+	if err != nil {
+		HandleServiceError(w, r, err)
+		return
+	}
+
+	// Write response.
+	var resp TenderSearchResp
+	resp.FromTenderSearch(search)
+	WriteValue(w, http.StatusOK, resp)
+}
@@ -1,9 +1,34 @@
 package http
 
 import (
+	"bytes"
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
 	"log/slog"
+	"math"
+	"net"
 	"net/http"
+	"runtime/debug"
+	"strconv"
+	"strings"
 	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/metrics"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/service"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/transport/http/handler"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/idempotency"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/logctx"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/ratelimit"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Middleware func(next http.Handler) http.Handler
@@ -25,33 +50,326 @@ func (w *loggerWriter) WriteHeader(statusCode int) {
 	w.ResponseWriter.WriteHeader(statusCode)
 }
 
-func LoggerMiddleware(logger *slog.Logger) Middleware {
+const RequestIDHeader = "X-Request-ID"
+
+const requestIDLength = 128
+
+// RequestIDMiddleware accepts request id from header or generates one, echoes it back
+// and sets context logger with request id.
+func RequestIDMiddleware(logger *slog.Logger) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := r.Header.Get(RequestIDHeader)
+			if requestID == "" || len(requestID) > requestIDLength ||
+				strings.ContainsFunc(requestID, func(r rune) bool { return r < '!' || r > '~' }) {
+				requestID = uuid.NewString()
+			}
+			w.Header().Set(RequestIDHeader, requestID)
+
+			ctx := handler.WithRequestID(r.Context(), requestID)
+			ctx = logctx.With(ctx, logger.With("request_id", requestID))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func LoggerMiddleware() Middleware {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			lw := &loggerWriter{ResponseWriter: w}
 			start := time.Now()
 			next.ServeHTTP(lw, r)
-			logger.Info(r.RemoteAddr,
+			logctx.From(r.Context()).InfoContext(r.Context(), "http request",
+				"remote_addr", r.RemoteAddr,
 				"method", r.Method,
 				"url", r.URL.String(),
 				"proto", r.Proto,
-				"code", lw.code,
+				"code", cmp.Or(lw.code, http.StatusOK),
 				"size", lw.size,
 				"time", time.Since(start))
 		})
 	}
 }
 
-func RecovererMiddleware(logger *slog.Logger) Middleware {
+func MetricsMiddleware(m *metrics.Metrics) Middleware {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			defer func() {
-				if rc := recover(); rc != nil {
-					w.WriteHeader(http.StatusInternalServerError)
-					if err, ok := rc.(error); ok {
-						logger.Error(r.RemoteAddr, "err", err)
+			m.RequestsInFlight.Inc()
+			defer m.RequestsInFlight.Dec()
+
+			lw := &loggerWriter{ResponseWriter: w}
+			start := time.Now()
+			next.ServeHTTP(lw, r)
+
+			// Pattern is set by router on same request, empty if no route matched.
+			route := cmp.Or(r.Pattern, "unmatched")
+			code := strconv.Itoa(cmp.Or(lw.code, http.StatusOK))
+			m.RequestsTotal.WithLabelValues(route, code).Inc()
+			m.RequestDuration.WithLabelValues(route, code).Observe(time.Since(start).Seconds())
+		})
+	}
+}
+
+// RateLimitMiddleware limits requests to route by client ip, and also by user if username belongs to existing user.
+// Username is read from query, or from body of creating routes. It is not authenticated,
+// so user limit is applied in addition to ip limit, not instead of it.
+// Route pattern is resolved by router before serving request and is set on request when it is rejected,
+// so outer middlewares see it.
+func RateLimitMiddleware(router *http.ServeMux, limiter ratelimit.Limiter, limits ratelimit.Limits,
+	employeeService service.Employee) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, pattern := router.Handler(r)
+			limit := limits.Route(pattern)
+			if limit.Unlimited() {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			result, err := limiter.Allow(r.Context(), pattern+" ip:"+clientIP(r), limit)
+			if err != nil {
+				// Requests are not limited while limiter is unavailable.
+				logctx.From(r.Context()).WarnContext(r.Context(), "rate limiter failed", "err", err)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// Unknown usernames are not limited separately, so they cannot exhaust limit of another user.
+			username := requestUsername(r)
+			if result.Allowed && username != "" {
+				if _, err := employeeService.GetUser(r.Context(), username); err == nil {
+					userResult, err := limiter.Allow(r.Context(), pattern+" user:"+username, limit)
+					if err != nil {
+						logctx.From(r.Context()).WarnContext(r.Context(), "rate limiter failed", "err", err)
+					} else if !userResult.Allowed || userResult.Remaining < result.Remaining {
+						result = userResult
 					}
 				}
+			}
+
+			header := w.Header()
+			header.Set("RateLimit-Limit", strconv.Itoa(result.Limit))
+			header.Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+			header.Set("RateLimit-Reset", ceilSeconds(result.Reset))
+			if !result.Allowed {
+				header.Set("Retry-After", ceilSeconds(result.RetryAfter))
+				r.Pattern = pattern
+				handler.WriteError(w, r, http.StatusTooManyRequests, "rate limit exceeded")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+const requestUsernameMaxSize = 1 << 20
+
+// requestUsername returns username of requester, which is passed in query, or as creator username in body
+// of creating routes. Body is restored, so it can be read again.
+func requestUsername(r *http.Request) string {
+	if username := r.URL.Query().Get("username"); username != "" {
+		return username
+	}
+	if r.Body == nil || r.Body == http.NoBody {
+		return ""
+	}
+
+	// Body above max size is not parsed, but it is still passed to handler whole.
+	body, err := io.ReadAll(io.LimitReader(r.Body, requestUsernameMaxSize+1))
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+	if err != nil || len(body) > requestUsernameMaxSize {
+		return ""
+	}
+
+	var req struct {
+		CreatorUsername string `json:"creatorUsername"`
+	}
+	if err = json.Unmarshal(body, &req); err != nil {
+		return ""
+	}
+	return req.CreatorUsername
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func ceilSeconds(d time.Duration) string { return strconv.Itoa(int(math.Ceil(d.Seconds()))) }
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotentReplayedHeader  = "Idempotent-Replayed"
+	idempotencyKeyLength      = 255
+	idempotencyRequestMaxSize = 1 << 20
+)
+
+type recorderWriter struct {
+	http.ResponseWriter
+	code int
+	body bytes.Buffer
+}
+
+func (w *recorderWriter) Write(bytes []byte) (int, error) {
+	w.body.Write(bytes)
+	return w.ResponseWriter.Write(bytes)
+}
+
+func (w *recorderWriter) WriteHeader(statusCode int) {
+	w.code = statusCode
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+// IdempotencyMiddleware replays stored response of request with same idempotency key of user and route.
+// Key reused with different request is rejected. Responses with 5xx code are not stored, so request can be retried.
+// It must wrap route handler, since it reads route pattern.
+func IdempotencyMiddleware(store idempotency.Store) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > idempotencyKeyLength {
+				handler.WriteError(w, r, http.StatusBadRequest,
+					fmt.Sprintf("idempotency key must be at most %d characters", idempotencyKeyLength))
+				return
+			}
+
+			// Fingerprint request by method, url and body, which is read to be fingerprinted.
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, idempotencyRequestMaxSize))
+			if err != nil {
+				handler.WriteError(w, r, http.StatusRequestEntityTooLarge, "request body is too large")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			hash := sha256.New()
+			hash.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+			hash.Write(body)
+			fingerprint := hash.Sum(nil)
+
+			// Keys are scoped by user and route.
+			key = requestUsername(r) + " " + r.Pattern + " " + key
+
+			ctx := r.Context()
+			record, err := store.Lock(ctx, key, fingerprint)
+			if err != nil {
+				handler.LogError(ctx, http.StatusInternalServerError, err)
+				handler.WriteError(w, r, http.StatusInternalServerError,
+					http.StatusText(http.StatusInternalServerError))
+				return
+			}
+			if record != nil {
+				switch {
+				case !bytes.Equal(record.Fingerprint, fingerprint):
+					handler.WriteError(w, r, http.StatusUnprocessableEntity,
+						"idempotency key is already used with different request")
+				case record.Response == nil:
+					handler.WriteError(w, r, http.StatusConflict,
+						"request with same idempotency key is in progress")
+				default:
+					if record.Response.ContentType != "" {
+						w.Header().Set("Content-Type", record.Response.ContentType)
+					}
+					w.Header().Set(IdempotentReplayedHeader, "true")
+					w.WriteHeader(record.Response.Code)
+					w.Write(record.Response.Body)
+				}
+				return
+			}
+
+			// Key is unlocked if request panics or fails, and response is stored even if client is gone.
+			completed := false
+			defer func() {
+				if completed {
+					return
+				}
+				if err := store.Unlock(context.WithoutCancel(ctx), key); err != nil {
+					handler.LogError(ctx, http.StatusInternalServerError, err)
+				}
+			}()
+
+			rw := &recorderWriter{ResponseWriter: w}
+			next.ServeHTTP(rw, r)
+			code := cmp.Or(rw.code, http.StatusOK)
+			if code >= http.StatusInternalServerError {
+				return
+			}
+
+			err = store.Save(context.WithoutCancel(ctx), key, idempotency.Response{
+				Code:        code,
+				ContentType: rw.Header().Get("Content-Type"),
+				Body:        rw.body.Bytes(),
+			})
+			if err != nil {
+				handler.LogError(ctx, http.StatusInternalServerError, err)
+				return
+			}
+			completed = true
+		})
+	}
+}
+
+const tracerName = "git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/" +
+	"zadanie-6105/internal/transport/http"
+
+// TracingMiddleware starts server span continuing trace from W3C trace context headers.
+func TracingMiddleware() Middleware {
+	tracer := otel.Tracer(tracerName)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+			ctx, span := tracer.Start(ctx, r.Method,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					attribute.String("http.request.method", r.Method),
+					attribute.String("url.path", r.URL.Path)))
+			defer span.End()
+
+			// Log trace id with request id to find trace of logged request.
+			if spanContext := span.SpanContext(); spanContext.IsValid() {
+				ctx = logctx.With(ctx, logctx.From(ctx).With("trace_id", spanContext.TraceID().String()))
+			}
+
+			lw := &loggerWriter{ResponseWriter: w}
+			r = r.WithContext(ctx)
+			next.ServeHTTP(lw, r)
+
+			// Pattern is set by router on same request, empty if no route matched.
+			if r.Pattern != "" {
+				span.SetName(r.Pattern)
+				span.SetAttributes(attribute.String("http.route", r.Pattern))
+			}
+			code := cmp.Or(lw.code, http.StatusOK)
+			span.SetAttributes(attribute.Int("http.response.status_code", code))
+			if code >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(code))
+			}
+		})
+	}
+}
+
+func RecovererMiddleware() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				rc := recover()
+				if rc == nil {
+					return
+				}
+				if rc == http.ErrAbortHandler {
+					panic(rc)
+				}
+				logctx.From(r.Context()).ErrorContext(r.Context(), "panic recovered",
+					"panic", rc, "stack", string(debug.Stack()))
+				handler.WriteError(w, r, http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
 			}()
 			next.ServeHTTP(w, r)
 		})
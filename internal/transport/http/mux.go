@@ -4,41 +4,114 @@ import (
 	"log/slog"
 	"net/http"
 
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/metrics"
 	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/service"
 	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/internal/transport/http/handler"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/health"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/idempotency"
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/ratelimit"
 )
 
 func NewMux(tenderService service.Tender, bidService service.Bid, reviewService service.BidReview,
-	logger *slog.Logger) http.Handler {
-	if tenderService == nil || bidService == nil || reviewService == nil || logger == nil {
+	questionService service.TenderQuestion, batchService service.Batch, categoryService service.ServiceCategory,
+	fieldService service.CustomField, watchService service.TenderWatch, notificationService service.Notification,
+	employeeService service.Employee, health *health.Health, idempotencyStore idempotency.Store,
+	limiter ratelimit.Limiter, limits ratelimit.Limits, metrics *metrics.Metrics, logger *slog.Logger) http.Handler {
+	if tenderService == nil || bidService == nil || reviewService == nil || questionService == nil ||
+		batchService == nil || categoryService == nil || fieldService == nil || watchService == nil ||
+		notificationService == nil || employeeService == nil || health == nil || idempotencyStore == nil ||
+		limiter == nil || metrics == nil || logger == nil {
 		return nil
 	}
 
+	// Creating requests are idempotent if client sets idempotency key.
+	idempotent := IdempotencyMiddleware(idempotencyStore)
+
 	router := http.NewServeMux()
 	router.Handle("GET /api/ping", handler.Ping{})
+	router.Handle("GET /healthz", handler.Healthz{})
+	router.Handle("GET /readyz", handler.Readyz{Health: health})
 
 	router.Handle("GET /api/tenders", handler.TenderGetByServiceType{Service: tenderService})
-	router.Handle("POST /api/tenders/new", handler.TenderCreate{Service: tenderService})
+	router.Handle("POST /api/tenders/new", idempotent(handler.TenderCreate{Service: tenderService}))
 	router.Handle("GET /api/tenders/my", handler.TenderGetByCreator{Service: tenderService})
+	router.Handle("GET /api/tenders/invited", handler.TenderGetByInvitee{Service: tenderService})
+	router.Handle("GET /api/tenders/tags", handler.TenderGetTags{Service: tenderService})
+	router.Handle("POST /api/tenders/batch/status", handler.TenderStatusBatch{Service: batchService})
 	router.Handle("GET /api/tenders/{tenderId}/status", handler.TenderGetStatus{Service: tenderService})
 	router.Handle("PUT /api/tenders/{tenderId}/status", handler.TenderUpdateStatus{Service: tenderService})
 	router.Handle("PATCH /api/tenders/{tenderId}/edit", handler.TenderUpdate{Service: tenderService})
 	router.Handle("PUT /api/tenders/{tenderId}/rollback/{version}", handler.TenderRollback{Service: tenderService})
+	router.Handle("PUT /api/tenders/{tenderId}/round/next", handler.TenderNextRound{Service: tenderService})
+	router.Handle("POST /api/tenders/{tenderId}/clone", handler.TenderClone{Service: tenderService})
+	router.Handle("POST /api/tenders/{tenderId}/template", handler.TenderTemplateCreate{Service: tenderService})
+	router.Handle("GET /api/tenders/templates", handler.TenderTemplateGetByOrganization{Service: tenderService})
+	router.Handle("POST /api/tenders/templates/{templateId}/tenders",
+		handler.TenderTemplateCreateTender{Service: tenderService})
+	router.Handle("DELETE /api/tenders/templates/{templateId}", handler.TenderTemplateDelete{Service: tenderService})
+	router.Handle("POST /api/tenders/{tenderId}/invitations", handler.TenderInvitationCreate{Service: tenderService})
+	router.Handle("GET /api/tenders/{tenderId}/invitations",
+		handler.TenderInvitationGetByTender{Service: tenderService})
+	router.Handle("DELETE /api/tenders/{tenderId}/invitations/{invitationId}",
+		handler.TenderInvitationDelete{Service: tenderService})
+
+	router.Handle("POST /api/tenders/{tenderId}/questions", handler.TenderQuestionCreate{Service: questionService})
+	router.Handle("GET /api/tenders/{tenderId}/questions", handler.TenderQuestionGetByTender{Service: questionService})
+	router.Handle("POST /api/tenders/{tenderId}/questions/{questionId}/answers",
+		handler.TenderQuestionAnswer{Service: questionService})
 
-	router.Handle("POST /api/bids/new", handler.BidCreate{Service: bidService})
+	router.Handle("POST /api/bids/new", idempotent(handler.BidCreate{Service: bidService}))
 	router.Handle("GET /api/bids/my", handler.BidGetByCreator{Service: bidService})
 	router.Handle("GET /api/bids/{tenderId}/list", handler.BidGetByTender{Service: bidService})
+	router.Handle("POST /api/bids/batch/status", handler.BidStatusBatch{Service: batchService})
 	router.Handle("GET /api/bids/{bidId}/status", handler.BidGetStatus{Service: bidService})
 	router.Handle("PUT /api/bids/{bidId}/status", handler.BidUpdateStatus{Service: bidService})
 	router.Handle("PATCH /api/bids/{bidId}/edit", handler.BidUpdate{Service: bidService})
-	router.Handle("PUT /api/bids/{bidId}/submit_decision", handler.BidSubmitDecision{Service: bidService})
+	router.Handle("PUT /api/bids/{bidId}/submit_decision",
+		idempotent(handler.BidSubmitDecision{Service: bidService}))
+	router.Handle("PUT /api/bids/{bidId}/revoke_decision", handler.BidRevokeDecision{Service: bidService})
+	router.Handle("GET /api/bids/{bidId}/decisions", handler.BidGetDecisions{Service: bidService})
 	router.Handle("PUT /api/bids/{bidId}/rollback/{version}", handler.BidRollback{Service: bidService})
+	router.Handle("PUT /api/bids/{bidId}/shortlist", handler.BidShortlist{Service: bidService})
+	router.Handle("PUT /api/bids/{bidId}/withdraw", handler.BidWithdraw{Service: bidService})
+	router.Handle("PUT /api/bids/{bidId}/resubmit", handler.BidResubmit{Service: bidService})
 
 	router.Handle("PUT /api/bids/{bidId}/feedback", handler.BidReviewCreate{Service: reviewService})
-	router.Handle("GET /api/bids/{tenderId}/reviews", handler.BidReviewGetByBidCreator{Service: reviewService})
+	router.Handle("GET /api/bids/{id}/reviews", handler.BidReviewGet{Service: reviewService})
+	router.Handle("GET /api/bids/reviews/my", handler.BidReviewGetByAuthor{Service: reviewService})
+
+	router.Handle("GET /api/service_categories", handler.ServiceCategoryGetAll{Service: categoryService})
+	router.Handle("POST /api/admin/service_categories", handler.ServiceCategoryCreate{Service: categoryService})
+	router.Handle("PATCH /api/admin/service_categories/{name}", handler.ServiceCategoryUpdate{Service: categoryService})
+	router.Handle("DELETE /api/admin/service_categories/{name}",
+		handler.ServiceCategoryDelete{Service: categoryService})
+
+	router.Handle("POST /api/organizations/{organizationId}/custom_fields",
+		handler.CustomFieldCreate{Service: fieldService})
+	router.Handle("GET /api/organizations/{organizationId}/custom_fields",
+		handler.CustomFieldGetByOrganization{Service: fieldService})
+	router.Handle("DELETE /api/organizations/{organizationId}/custom_fields/{fieldId}",
+		handler.CustomFieldDelete{Service: fieldService})
+
+	router.Handle("POST /api/tenders/{tenderId}/watch", handler.TenderWatchCreate{Service: watchService})
+	router.Handle("GET /api/watches", handler.TenderWatchGetByUser{Service: watchService})
+	router.Handle("DELETE /api/watches/{watchId}", handler.TenderWatchDelete{Service: watchService})
+	router.Handle("POST /api/searches", handler.TenderSearchCreate{Service: watchService})
+	router.Handle("GET /api/searches", handler.TenderSearchGetByUser{Service: watchService})
+	router.Handle("DELETE /api/searches/{searchId}", handler.TenderSearchDelete{Service: watchService})
+
+	router.Handle("GET /api/notifications", handler.NotificationGetByUser{Service: notificationService})
+	router.Handle("PUT /api/notifications/{notificationId}/read",
+		handler.NotificationMarkRead{Service: notificationService})
 
 	var mux http.Handler = router
-	middlewares := []Middleware{RecovererMiddleware(logger), LoggerMiddleware(logger)}
+	// Middlewares are applied from inner to outer, so context logger and trace are set before logging.
+	// Route pattern is set by router on request passed to it, so middlewares reading it must not be
+	// wrapped by middlewares replacing request.
+	middlewares := []Middleware{
+		RateLimitMiddleware(router, limiter, limits, employeeService), RecovererMiddleware(), LoggerMiddleware(),
+		MetricsMiddleware(metrics), TracingMiddleware(), RequestIDMiddleware(logger),
+	}
 	for _, middleware := range middlewares {
 		mux = middleware(mux)
 	}
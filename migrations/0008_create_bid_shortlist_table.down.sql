@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS bid_shortlist;
+ALTER TABLE bid DROP COLUMN IF EXISTS round;
+ALTER TABLE tender DROP COLUMN IF EXISTS round;
\ No newline at end of file
@@ -0,0 +1,15 @@
+ALTER TABLE tender ADD COLUMN IF NOT EXISTS round INT NOT NULL CHECK (round >= 1) DEFAULT 1;
+
+ALTER TABLE bid ADD COLUMN IF NOT EXISTS round INT NOT NULL CHECK (round >= 1) DEFAULT 1;
+
+CREATE TABLE IF NOT EXISTS bid_shortlist (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    bid_id UUID NOT NULL UNIQUE,
+    tender_id UUID NOT NULL,
+    round INT NOT NULL CHECK (round >= 1),
+    organization_id UUID REFERENCES organization(id) ON DELETE SET NULL,
+    creator_id UUID NOT NULL REFERENCES employee(id) ON DELETE RESTRICT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS bid_shortlist_tender_round_idx ON bid_shortlist (tender_id, round);
\ No newline at end of file
@@ -0,0 +1,18 @@
+DO $$ BEGIN
+    CREATE TYPE tender_visibility AS ENUM ('Public', 'InviteOnly');
+EXCEPTION
+    WHEN duplicate_object THEN NULL;
+END $$;
+
+ALTER TABLE tender ADD COLUMN IF NOT EXISTS visibility tender_visibility NOT NULL DEFAULT 'Public';
+
+CREATE TABLE IF NOT EXISTS tender_invitation (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tender_id UUID NOT NULL,
+    organization_id UUID REFERENCES organization(id) ON DELETE CASCADE,
+    user_id UUID REFERENCES employee(id) ON DELETE CASCADE,
+    creator_id UUID NOT NULL REFERENCES employee(id) ON DELETE RESTRICT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((organization_id IS NULL) <> (user_id IS NULL)),
+    UNIQUE NULLS NOT DISTINCT (tender_id, organization_id, user_id)
+);
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS tender_answer;
+DROP TABLE IF EXISTS tender_question;
+DROP TYPE IF EXISTS tender_answer_visibility;
\ No newline at end of file
@@ -0,0 +1,26 @@
+DO $$ BEGIN
+    CREATE TYPE tender_answer_visibility AS ENUM ('Public', 'Private');
+EXCEPTION
+    WHEN duplicate_object THEN NULL;
+END $$;
+
+CREATE TABLE IF NOT EXISTS tender_question (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tender_id UUID NOT NULL,
+    description VARCHAR(1000) NOT NULL,
+    creator_id UUID NOT NULL REFERENCES employee(id) ON DELETE RESTRICT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS tender_answer (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    question_id UUID NOT NULL REFERENCES tender_question(id) ON DELETE CASCADE,
+    description VARCHAR(1000) NOT NULL,
+    visibility tender_answer_visibility NOT NULL,
+    organization_id UUID REFERENCES organization(id) ON DELETE SET NULL,
+    creator_id UUID NOT NULL REFERENCES employee(id) ON DELETE RESTRICT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS tender_question_tender_id_idx ON tender_question (tender_id);
+CREATE INDEX IF NOT EXISTS tender_answer_question_id_idx ON tender_answer (question_id);
\ No newline at end of file
@@ -0,0 +1,2 @@
+ALTER TABLE bid_decision DROP COLUMN IF EXISTS reset_at;
+ALTER TABLE bid DROP COLUMN IF EXISTS reason;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE bid ADD COLUMN IF NOT EXISTS reason VARCHAR(500);
+
+ALTER TABLE bid_decision ADD COLUMN IF NOT EXISTS reset_at TIMESTAMPTZ;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE bid_decision DROP COLUMN IF EXISTS bid_version;
\ No newline at end of file
@@ -0,0 +1,7 @@
+ALTER TABLE bid_decision ADD COLUMN IF NOT EXISTS bid_version INT CHECK (bid_version >= 1);
+
+UPDATE bid_decision 
+SET bid_version = COALESCE((SELECT MAX(version) FROM bid WHERE bid.id = bid_decision.bid_id), 1) 
+WHERE bid_version IS NULL;
+
+ALTER TABLE bid_decision ALTER COLUMN bid_version SET NOT NULL;
\ No newline at end of file
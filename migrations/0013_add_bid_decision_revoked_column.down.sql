@@ -0,0 +1 @@
+ALTER TABLE bid_decision DROP COLUMN IF EXISTS revoked_at;
\ No newline at end of file
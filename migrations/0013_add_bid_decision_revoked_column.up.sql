@@ -0,0 +1 @@
+ALTER TABLE bid_decision ADD COLUMN IF NOT EXISTS revoked_at TIMESTAMPTZ;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE tender DROP COLUMN IF EXISTS template_id;
+ALTER TABLE tender DROP COLUMN IF EXISTS source_id;
+DROP TABLE IF EXISTS tender_template;
\ No newline at end of file
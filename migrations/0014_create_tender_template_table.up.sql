@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS tender_template (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(100) NOT NULL,
+    description VARCHAR(500) NOT NULL,
+    service_type tender_service_type NOT NULL,
+    visibility tender_visibility NOT NULL,
+    organization_id UUID NOT NULL REFERENCES organization(id) ON DELETE CASCADE,
+    creator_id UUID NOT NULL REFERENCES employee(id) ON DELETE RESTRICT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS tender_template_organization_id_idx ON tender_template (organization_id);
+
+ALTER TABLE tender ADD COLUMN IF NOT EXISTS source_id UUID;
+ALTER TABLE tender ADD COLUMN IF NOT EXISTS template_id UUID;
\ No newline at end of file
@@ -0,0 +1,14 @@
+DO $$ BEGIN
+    CREATE TYPE tender_service_type AS ENUM ('Construction', 'Delivery', 'Manufacture');
+EXCEPTION
+    WHEN duplicate_object THEN NULL;
+END $$;
+
+ALTER TABLE tender_template DROP CONSTRAINT IF EXISTS tender_template_service_type_fkey;
+ALTER TABLE tender_template ALTER COLUMN service_type TYPE tender_service_type
+    USING service_type::tender_service_type;
+
+ALTER TABLE tender DROP CONSTRAINT IF EXISTS tender_service_type_fkey;
+ALTER TABLE tender ALTER COLUMN service_type TYPE tender_service_type USING service_type::tender_service_type;
+
+DROP TABLE IF EXISTS service_category;
\ No newline at end of file
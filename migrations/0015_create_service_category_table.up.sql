@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS service_category (
+    name VARCHAR(50) PRIMARY KEY,
+    description VARCHAR(500) NOT NULL DEFAULT '',
+    parent_name VARCHAR(50) REFERENCES service_category(name) ON DELETE RESTRICT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CHECK (parent_name <> name)
+);
+
+CREATE INDEX IF NOT EXISTS service_category_parent_name_idx ON service_category (parent_name);
+
+INSERT INTO service_category (name) VALUES ('Construction'), ('Delivery'), ('Manufacture') ON CONFLICT DO NOTHING;
+
+ALTER TABLE tender ALTER COLUMN service_type TYPE VARCHAR(50) USING service_type::TEXT;
+ALTER TABLE tender ADD CONSTRAINT tender_service_type_fkey
+    FOREIGN KEY (service_type) REFERENCES service_category(name) ON DELETE RESTRICT;
+
+ALTER TABLE tender_template ALTER COLUMN service_type TYPE VARCHAR(50) USING service_type::TEXT;
+ALTER TABLE tender_template ADD CONSTRAINT tender_template_service_type_fkey
+    FOREIGN KEY (service_type) REFERENCES service_category(name) ON DELETE RESTRICT;
+
+DROP TYPE IF EXISTS tender_service_type;
\ No newline at end of file
@@ -0,0 +1,17 @@
+DROP INDEX IF EXISTS tender_region_idx;
+
+ALTER TABLE tender_template
+    DROP COLUMN IF EXISTS delivery_date,
+    DROP COLUMN IF EXISTS address,
+    DROP COLUMN IF EXISTS region,
+    DROP COLUMN IF EXISTS currency,
+    DROP COLUMN IF EXISTS budget_max,
+    DROP COLUMN IF EXISTS budget_min;
+
+ALTER TABLE tender
+    DROP COLUMN IF EXISTS delivery_date,
+    DROP COLUMN IF EXISTS address,
+    DROP COLUMN IF EXISTS region,
+    DROP COLUMN IF EXISTS currency,
+    DROP COLUMN IF EXISTS budget_max,
+    DROP COLUMN IF EXISTS budget_min;
\ No newline at end of file
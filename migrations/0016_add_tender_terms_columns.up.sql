@@ -0,0 +1,17 @@
+ALTER TABLE tender
+    ADD COLUMN IF NOT EXISTS budget_min BIGINT CHECK (budget_min >= 0),
+    ADD COLUMN IF NOT EXISTS budget_max BIGINT CHECK (budget_max >= budget_min),
+    ADD COLUMN IF NOT EXISTS currency VARCHAR(3),
+    ADD COLUMN IF NOT EXISTS region VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS address VARCHAR(500),
+    ADD COLUMN IF NOT EXISTS delivery_date DATE;
+
+ALTER TABLE tender_template
+    ADD COLUMN IF NOT EXISTS budget_min BIGINT CHECK (budget_min >= 0),
+    ADD COLUMN IF NOT EXISTS budget_max BIGINT CHECK (budget_max >= budget_min),
+    ADD COLUMN IF NOT EXISTS currency VARCHAR(3),
+    ADD COLUMN IF NOT EXISTS region VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS address VARCHAR(500),
+    ADD COLUMN IF NOT EXISTS delivery_date DATE;
+
+CREATE INDEX IF NOT EXISTS tender_region_idx ON tender (region);
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE bid DROP COLUMN IF EXISTS custom_fields;
+ALTER TABLE tender_template DROP COLUMN IF EXISTS custom_fields;
+ALTER TABLE tender DROP COLUMN IF EXISTS custom_fields;
+DROP TABLE IF EXISTS custom_field;
+DROP TYPE IF EXISTS custom_field_target;
+DROP TYPE IF EXISTS custom_field_type;
\ No newline at end of file
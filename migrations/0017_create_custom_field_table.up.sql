@@ -0,0 +1,28 @@
+DO $$ BEGIN
+    CREATE TYPE custom_field_type AS ENUM ('String', 'Number', 'Boolean', 'Date');
+EXCEPTION
+    WHEN duplicate_object THEN NULL;
+END $$;
+
+DO $$ BEGIN
+    CREATE TYPE custom_field_target AS ENUM ('Tender', 'Bid');
+EXCEPTION
+    WHEN duplicate_object THEN NULL;
+END $$;
+
+CREATE TABLE IF NOT EXISTS custom_field (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id UUID NOT NULL REFERENCES organization(id) ON DELETE CASCADE,
+    target custom_field_target NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    type custom_field_type NOT NULL,
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    allowed_values JSONB NOT NULL DEFAULT '[]',
+    creator_id UUID NOT NULL REFERENCES employee(id) ON DELETE RESTRICT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (organization_id, target, name)
+);
+
+ALTER TABLE tender ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE tender_template ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE bid ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '{}';
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS tender_tags_idx;
+ALTER TABLE tender_template DROP COLUMN IF EXISTS tags;
+ALTER TABLE tender DROP COLUMN IF EXISTS tags;
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE tender ADD COLUMN IF NOT EXISTS tags VARCHAR(50)[] NOT NULL DEFAULT '{}';
+ALTER TABLE tender_template ADD COLUMN IF NOT EXISTS tags VARCHAR(50)[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS tender_tags_idx ON tender USING GIN (tags);
\ No newline at end of file
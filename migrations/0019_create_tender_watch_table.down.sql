@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS tender_delivery_date_idx;
+DROP TABLE IF EXISTS notification;
+DROP TABLE IF EXISTS tender_search;
+DROP TABLE IF EXISTS tender_watch;
+DROP TYPE IF EXISTS notification_event;
\ No newline at end of file
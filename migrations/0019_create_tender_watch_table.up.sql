@@ -0,0 +1,45 @@
+DO $$ BEGIN
+    CREATE TYPE notification_event AS ENUM ('TenderPublished', 'TenderUpdated', 'TenderDeadline');
+EXCEPTION
+    WHEN duplicate_object THEN NULL;
+END $$;
+
+CREATE TABLE IF NOT EXISTS tender_watch (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tender_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES employee(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (tender_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS tender_watch_user_id_idx ON tender_watch (user_id);
+
+CREATE TABLE IF NOT EXISTS tender_search (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES employee(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    service_type VARCHAR(50) REFERENCES service_category(name) ON DELETE CASCADE,
+    tags VARCHAR(50)[] NOT NULL DEFAULT '{}',
+    tags_match_all BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS tender_search_user_id_idx ON tender_search (user_id);
+CREATE INDEX IF NOT EXISTS tender_search_service_type_idx ON tender_search (service_type);
+CREATE INDEX IF NOT EXISTS tender_search_tags_idx ON tender_search USING GIN (tags);
+
+CREATE TABLE IF NOT EXISTS notification (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES employee(id) ON DELETE CASCADE,
+    tender_id UUID NOT NULL,
+    tender_version INT NOT NULL,
+    event notification_event NOT NULL,
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS notification_user_id_idx ON notification (user_id, created_at DESC);
+CREATE UNIQUE INDEX IF NOT EXISTS notification_deadline_idx ON notification (user_id, tender_id)
+    WHERE event = 'TenderDeadline';
+
+CREATE INDEX IF NOT EXISTS tender_delivery_date_idx ON tender (delivery_date);
\ No newline at end of file
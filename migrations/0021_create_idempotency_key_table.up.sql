@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS idempotency_key (
+    key TEXT PRIMARY KEY,
+    fingerprint BYTEA NOT NULL,
+    code INT,
+    content_type VARCHAR(255),
+    body BYTEA,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idempotency_key_created_at_idx ON idempotency_key (created_at);
\ No newline at end of file
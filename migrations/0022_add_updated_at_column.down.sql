@@ -0,0 +1,2 @@
+ALTER TABLE bid DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE tender DROP COLUMN IF EXISTS updated_at;
\ No newline at end of file
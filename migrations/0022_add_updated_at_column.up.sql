@@ -0,0 +1,5 @@
+ALTER TABLE tender ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE bid ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+UPDATE tender SET updated_at = created_at WHERE created_at IS NOT NULL;
+UPDATE bid SET updated_at = created_at WHERE created_at IS NOT NULL;
\ No newline at end of file
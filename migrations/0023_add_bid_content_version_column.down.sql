@@ -0,0 +1 @@
+ALTER TABLE bid DROP COLUMN IF EXISTS content_version;
\ No newline at end of file
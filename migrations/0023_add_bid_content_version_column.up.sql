@@ -0,0 +1,6 @@
+ALTER TABLE bid ADD COLUMN IF NOT EXISTS content_version INT CHECK (content_version >= 1);
+
+UPDATE bid SET content_version = version WHERE content_version IS NULL;
+
+ALTER TABLE bid ALTER COLUMN content_version SET NOT NULL;
+ALTER TABLE bid ALTER COLUMN content_version SET DEFAULT 1;
\ No newline at end of file
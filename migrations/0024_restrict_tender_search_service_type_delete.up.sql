@@ -0,0 +1,3 @@
+ALTER TABLE tender_search DROP CONSTRAINT IF EXISTS tender_search_service_type_fkey;
+ALTER TABLE tender_search ADD CONSTRAINT tender_search_service_type_fkey
+    FOREIGN KEY (service_type) REFERENCES service_category(name) ON DELETE RESTRICT;
\ No newline at end of file
@@ -0,0 +1,55 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/logctx"
+)
+
+// Cache stores values by key for ttl.
+type Cache interface {
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+	Close() error
+}
+
+// Load returns value cached by key, or loads value by fn and caches it.
+// Values are encoded as JSON, so callers get own copies. Errors of cache are logged and value is loaded.
+func Load[V any](ctx context.Context,
+	c Cache, key string, ttl time.Duration, fn func() (V, error)) (V, error) {
+	data, ok, err := c.Get(ctx, key)
+	if err != nil {
+		logctx.From(ctx).WarnContext(ctx, "failed to get cached value", "key", key, "err", err)
+	}
+	if ok {
+		var value V
+		if err = json.Unmarshal(data, &value); err == nil {
+			return value, nil
+		}
+		logctx.From(ctx).WarnContext(ctx, "failed to decode cached value", "key", key, "err", err)
+	}
+
+	value, err := fn()
+	if err != nil {
+		return value, err
+	}
+
+	if data, err = json.Marshal(value); err != nil {
+		logctx.From(ctx).WarnContext(ctx, "failed to encode cached value", "key", key, "err", err)
+		return value, nil
+	}
+	if err = c.Set(ctx, key, data, ttl); err != nil {
+		logctx.From(ctx).WarnContext(ctx, "failed to set cached value", "key", key, "err", err)
+	}
+	return value, nil
+}
+
+// Invalidate deletes cached values by keys. Errors of cache are logged.
+func Invalidate(ctx context.Context, c Cache, keys ...string) {
+	if err := c.Delete(ctx, keys...); err != nil {
+		logctx.From(ctx).WarnContext(ctx, "failed to delete cached values", "keys", keys, "err", err)
+	}
+}
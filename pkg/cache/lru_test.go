@@ -0,0 +1,73 @@
+package cache_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/cache"
+)
+
+const (
+	benchLRUSize = 1024
+	benchLRUKeys = 4096 // more keys than cache size, so sets evict entries
+)
+
+func benchLRUKeyNames() []string {
+	keys := make([]string, benchLRUKeys)
+	for i := range keys {
+		keys[i] = "key:" + strconv.Itoa(i)
+	}
+	return keys
+}
+
+// BenchmarkLRUGet measures concurrent gets of cached keys.
+func BenchmarkLRUGet(b *testing.B) {
+	ctx := context.Background()
+	c := cache.NewLRU(benchLRUSize)
+	keys := benchLRUKeyNames()[:benchLRUSize]
+	for _, key := range keys {
+		_ = c.Set(ctx, key, []byte("value"), time.Hour)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			_, _, _ = c.Get(ctx, keys[i%len(keys)])
+		}
+	})
+}
+
+// BenchmarkLRUSet measures concurrent sets, which evict least recently used keys.
+func BenchmarkLRUSet(b *testing.B) {
+	ctx := context.Background()
+	c := cache.NewLRU(benchLRUSize)
+	keys := benchLRUKeyNames()
+	value := []byte("value")
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			_ = c.Set(ctx, keys[i%len(keys)], value, time.Hour)
+		}
+	})
+}
+
+// BenchmarkLRUGetSet measures concurrent gets, which set value on every miss.
+func BenchmarkLRUGetSet(b *testing.B) {
+	ctx := context.Background()
+	c := cache.NewLRU(benchLRUSize)
+	keys := benchLRUKeyNames()
+	value := []byte("value")
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			key := keys[i%len(keys)]
+			if _, ok, _ := c.Get(ctx, key); !ok {
+				_ = c.Set(ctx, key, value, time.Hour)
+			}
+		}
+	})
+}
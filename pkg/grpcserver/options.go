@@ -0,0 +1,9 @@
+package grpcserver
+
+type Option func(*Server)
+
+func Addr(addr string) Option {
+	return func(s *Server) {
+		s.addr = addr
+	}
+}
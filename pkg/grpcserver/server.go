@@ -0,0 +1,58 @@
+package grpcserver
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+)
+
+type Server struct {
+	server *grpc.Server
+	addr   string
+	errCh  chan error
+}
+
+func New(server *grpc.Server, opts ...Option) *Server {
+	s := &Server{
+		server: server,
+		errCh:  make(chan error, 1),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+func (s *Server) Start() {
+	go func() {
+		listener, err := net.Listen("tcp", s.addr)
+		if err == nil {
+			err = s.server.Serve(listener)
+		}
+		s.errCh <- err
+		close(s.errCh)
+	}()
+}
+
+func (s *Server) Err() <-chan error { return s.errCh }
+
+// Stop stops server gracefully, waiting for pending requests.
+// If context is done first, server is stopped immediately and context error is returned.
+func (s *Server) Stop(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.server.Stop()
+		return ctx.Err()
+	}
+}
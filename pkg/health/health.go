@@ -0,0 +1,105 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	StatusOK           = "ok"
+	StatusUnavailable  = "unavailable"
+	StatusShuttingDown = "shutting down"
+)
+
+// CheckFunc returns error if dependency is not available.
+type CheckFunc func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check CheckFunc
+}
+
+// Health reports readiness of application by its dependency checks and shutdown state.
+type Health struct {
+	checks   []namedCheck
+	timeout  time.Duration
+	started  time.Time
+	shutdown atomic.Bool
+}
+
+func New(opts ...Option) *Health {
+	h := &Health{
+		timeout: 2 * time.Second,
+		started: time.Now(),
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// Shutdown marks application as shutting down, so it is not ready anymore.
+func (h *Health) Shutdown() { h.shutdown.Store(true) }
+
+// CheckStatus is result of dependency check.
+type CheckStatus struct {
+	Name     string
+	Status   string
+	Err      error
+	Duration time.Duration
+}
+
+// Status is readiness of application.
+type Status struct {
+	Status string
+	Uptime time.Duration
+	Checks []CheckStatus
+}
+
+func (s *Status) Ready() bool { return s.Status == StatusOK }
+
+// Check runs dependency checks concurrently and returns readiness of application.
+func (h *Health) Check(ctx context.Context) Status {
+	status := Status{
+		Status: StatusOK,
+		Uptime: time.Since(h.started),
+		Checks: make([]CheckStatus, len(h.checks)),
+	}
+
+	var wg sync.WaitGroup
+	for i, c := range h.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cctx, cancel := context.WithTimeout(ctx, h.timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := c.check(cctx)
+			status.Checks[i] = CheckStatus{
+				Name:     c.name,
+				Status:   StatusOK,
+				Err:      err,
+				Duration: time.Since(start),
+			}
+			if err != nil {
+				status.Checks[i].Status = StatusUnavailable
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, c := range status.Checks {
+		if c.Err != nil {
+			status.Status = StatusUnavailable
+		}
+	}
+	if h.shutdown.Load() {
+		status.Status = StatusShuttingDown
+	}
+	return status
+}
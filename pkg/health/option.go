@@ -0,0 +1,19 @@
+package health
+
+import "time"
+
+type Option func(*Health)
+
+// Check adds named dependency check, which must pass for readiness.
+func Check(name string, check CheckFunc) Option {
+	return func(h *Health) {
+		h.checks = append(h.checks, namedCheck{name, check})
+	}
+}
+
+// Timeout sets timeout of each dependency check.
+func Timeout(timeout time.Duration) Option {
+	return func(h *Health) {
+		h.timeout = timeout
+	}
+}
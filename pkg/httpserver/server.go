@@ -18,6 +18,7 @@ func New(handler http.Handler, opts ...Option) *Server {
 
 	server := &Server{
 		server: httpServer,
+		errCh:  make(chan error, 1),
 	}
 
 	for _, opt := range opts {
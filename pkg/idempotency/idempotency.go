@@ -0,0 +1,37 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// TTL is time during which response is replayed for key.
+	TTL = 24 * time.Hour
+	// LockTimeout is time after which key of request, which has not completed, can be locked again.
+	LockTimeout = time.Minute
+)
+
+// Response is stored response of request.
+type Response struct {
+	Code        int
+	ContentType string
+	Body        []byte
+}
+
+// Record is key stored by request, which response is nil until request completes.
+type Record struct {
+	Fingerprint []byte
+	Response    *Response
+}
+
+// Store stores responses of requests by idempotency key.
+type Store interface {
+	// Lock creates record of key if it does not exist or is expired and returns nil,
+	// otherwise returns existing record.
+	Lock(ctx context.Context, key string, fingerprint []byte) (*Record, error)
+	// Save stores response of request, which locked key.
+	Save(ctx context.Context, key string, response Response) error
+	// Unlock deletes record of key, so request can be retried.
+	Unlock(ctx context.Context, key string) error
+}
@@ -0,0 +1,98 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+const sweepInterval = time.Minute
+
+type postgresStore struct {
+	*postgres.Postgres
+	mu    sync.Mutex
+	swept time.Time
+}
+
+// NewPostgres returns store of keys in idempotency_key table.
+func NewPostgres(pg *postgres.Postgres) Store {
+	if pg == nil {
+		return nil
+	}
+	return &postgresStore{Postgres: pg, swept: time.Now()}
+}
+
+func (s *postgresStore) Lock(ctx context.Context, key string, fingerprint []byte) (*Record, error) {
+	if err := s.sweep(ctx); err != nil {
+		return nil, err
+	}
+
+	// Expired key and key of request, which has not completed in time, are locked again.
+	const lockQuery = `INSERT INTO idempotency_key AS ik (key, fingerprint) VALUES ($1, $2)
+		ON CONFLICT (key) DO UPDATE SET fingerprint = $2, code = NULL, content_type = NULL, body = NULL,
+		created_at = CURRENT_TIMESTAMP
+		WHERE ik.created_at < CURRENT_TIMESTAMP - $3::FLOAT8 * INTERVAL '1 second'
+		OR (ik.code IS NULL AND ik.created_at < CURRENT_TIMESTAMP - $4::FLOAT8 * INTERVAL '1 second')
+		RETURNING key`
+
+	err := s.Conn(ctx).QueryRow(ctx, lockQuery, key, fingerprint,
+		TTL.Seconds(), LockTimeout.Seconds()).Scan(&key)
+	if err == nil {
+		return nil, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	const recordQuery = `SELECT fingerprint, code, content_type, body FROM idempotency_key WHERE key = $1`
+
+	var record Record
+	var code *int
+	var contentType *string
+	var body []byte
+	err = s.Conn(ctx).QueryRow(ctx, recordQuery, key).Scan(&record.Fingerprint, &code, &contentType, &body)
+	if err != nil {
+		return nil, err
+	}
+	if code != nil {
+		record.Response = &Response{Code: *code, Body: body}
+		if contentType != nil {
+			record.Response.ContentType = *contentType
+		}
+	}
+	return &record, nil
+}
+
+func (s *postgresStore) Save(ctx context.Context, key string, response Response) error {
+	const query = `UPDATE idempotency_key SET code = $2, content_type = $3, body = $4 WHERE key = $1`
+
+	_, err := s.Conn(ctx).Exec(ctx, query, key, response.Code, response.ContentType, response.Body)
+	return err
+}
+
+func (s *postgresStore) Unlock(ctx context.Context, key string) error {
+	const query = `DELETE FROM idempotency_key WHERE key = $1 AND code IS NULL`
+
+	_, err := s.Conn(ctx).Exec(ctx, query, key)
+	return err
+}
+
+// sweep removes expired keys.
+func (s *postgresStore) sweep(ctx context.Context) error {
+	s.mu.Lock()
+	if time.Since(s.swept) < sweepInterval {
+		s.mu.Unlock()
+		return nil
+	}
+	s.swept = time.Now()
+	s.mu.Unlock()
+
+	const query = `DELETE FROM idempotency_key WHERE created_at < CURRENT_TIMESTAMP - $1::FLOAT8 * INTERVAL '1 second'`
+
+	_, err := s.Conn(ctx).Exec(ctx, query, TTL.Seconds())
+	return err
+}
@@ -26,6 +26,8 @@ func New(ctx context.Context, uri string, opts ...Option) (*Postgres, error) {
 		return nil, err
 	}
 
+	cfg.ConnConfig.Tracer = newQueryTracer()
+
 	cfg.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
 		for _, typeName := range pg.dataTypes {
 			dataType, err := conn.LoadType(ctx, typeName)
@@ -64,3 +66,5 @@ func (p *Postgres) Close() {
 		p.Pool.Close()
 	}
 }
+
+func (p *Postgres) Ping(ctx context.Context) error { return p.Pool.Ping(ctx) }
@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/logctx"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/" +
+	"zadanie-6105/pkg/postgres"
+
+type querySQLKey struct{}
+
+// queryTracer starts span for each query using global tracer provider and logs failed queries.
+type queryTracer struct {
+	tracer trace.Tracer
+}
+
+func newQueryTracer() *queryTracer {
+	return &queryTracer{otel.Tracer(tracerName)}
+}
+
+func (t *queryTracer) TraceQueryStart(ctx context.Context,
+	_ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	// Name span by statement keyword, e.g. "postgres SELECT".
+	name := "postgres"
+	if fields := strings.Fields(data.SQL); len(fields) > 0 {
+		name += " " + strings.ToUpper(fields[0])
+	}
+
+	ctx, _ = t.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "postgresql"),
+			attribute.String("db.statement", data.SQL)))
+	return context.WithValue(ctx, querySQLKey{}, data.SQL)
+}
+
+func (t *queryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	if data.Err != nil && !errors.Is(data.Err, pgx.ErrNoRows) {
+		sql, _ := ctx.Value(querySQLKey{}).(string)
+		logctx.From(ctx).WarnContext(ctx, "query failed", "sql", sql, "err", data.Err)
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+		return
+	}
+
+	span.SetAttributes(attribute.Int64("db.rows_affected", data.CommandTag.RowsAffected()))
+}
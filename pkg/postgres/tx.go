@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Conn is implemented by both pool and transaction.
+type Conn interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type txKey struct{}
+
+type afterCommitKey struct{}
+
+// Conn returns transaction started by InTx if context carries one, otherwise pool.
+func (p *Postgres) Conn(ctx context.Context) Conn {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return p.Pool
+}
+
+// InTx runs fn in transaction which is committed if fn returns nil and rolled back otherwise.
+// Nested calls join outer transaction.
+func (p *Postgres) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := p.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	return RunTx(ctx, tx, fn)
+}
+
+// RunTx runs fn in started transaction, which is committed if fn returns nil and rolled back otherwise.
+// Functions registered by AfterCommit are run after transaction is committed.
+func RunTx(ctx context.Context, tx pgx.Tx, fn func(ctx context.Context) error) error {
+	defer tx.Rollback(ctx)
+
+	afterCommit := new([]func())
+	ctx = context.WithValue(ctx, afterCommitKey{}, afterCommit)
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	for _, f := range *afterCommit {
+		f()
+	}
+	return nil
+}
+
+// HasTx reports whether context carries transaction started by InTx.
+func HasTx(ctx context.Context) bool {
+	_, ok := ctx.Value(txKey{}).(pgx.Tx)
+	return ok
+}
+
+// AfterCommit runs fn after transaction started by InTx is committed, or immediately if context carries none.
+func AfterCommit(ctx context.Context, fn func()) {
+	if afterCommit, ok := ctx.Value(afterCommitKey{}).(*[]func()); ok && HasTx(ctx) {
+		*afterCommit = append(*afterCommit, fn)
+		return
+	}
+	fn()
+}
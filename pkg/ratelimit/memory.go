@@ -0,0 +1,45 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const sweepInterval = time.Minute
+
+type memory struct {
+	mu    sync.Mutex
+	tats  map[string]time.Time
+	swept time.Time
+}
+
+// NewMemory returns limiter storing buckets in memory of single replica.
+func NewMemory() Limiter {
+	return &memory{tats: make(map[string]time.Time), swept: time.Now()}
+}
+
+func (m *memory) Allow(_ context.Context, key string, limit Limit) (Result, error) {
+	if limit.Unlimited() {
+		return Result{Allowed: true}, nil
+	}
+
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Remove refilled buckets, which are same as missing ones.
+	if now.Sub(m.swept) >= sweepInterval {
+		for k, tat := range m.tats {
+			if tat.Before(now) {
+				delete(m.tats, k)
+			}
+		}
+		m.swept = now
+	}
+
+	tat, result := allow(limit, m.tats[key], now)
+	m.tats[key] = tat
+	return result, nil
+}
@@ -0,0 +1,79 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"git.codenrock.com/avito-testirovanie-na-backend-1270/cnrprod1725732425-team-77001/zadanie-6105/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+type postgresLimiter struct {
+	*postgres.Postgres
+	mu    sync.Mutex
+	swept time.Time
+}
+
+// NewPostgres returns limiter storing buckets in rate_limit table, which is shared by replicas.
+// Time of database is used, so replicas agree on it.
+func NewPostgres(pg *postgres.Postgres) Limiter {
+	if pg == nil {
+		return nil
+	}
+	return &postgresLimiter{Postgres: pg, swept: time.Now()}
+}
+
+func (l *postgresLimiter) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	if limit.Unlimited() {
+		return Result{Allowed: true}, nil
+	}
+
+	if err := l.sweep(ctx); err != nil {
+		return Result{}, err
+	}
+
+	// Bucket is updated only if request is allowed.
+	const allowQuery = `INSERT INTO rate_limit AS rl (key, tat)
+		VALUES ($1, now() + $2::FLOAT8 * INTERVAL '1 second')
+		ON CONFLICT (key) DO UPDATE SET tat = GREATEST(rl.tat, now()) + $2::FLOAT8 * INTERVAL '1 second'
+		WHERE GREATEST(rl.tat, now()) + $2::FLOAT8 * INTERVAL '1 second' <= now() + $3::FLOAT8 * INTERVAL '1 second'
+		RETURNING tat, now()`
+
+	var tat, now time.Time
+	err := l.Conn(ctx).QueryRow(ctx, allowQuery, key,
+		limit.interval().Seconds(), limit.Period.Seconds()).Scan(&tat, &now)
+	if err == nil {
+		_, result := allow(limit, tat.Add(-limit.interval()), now)
+		return result, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return Result{}, err
+	}
+
+	const bucketQuery = `SELECT tat, now() FROM rate_limit WHERE key = $1`
+
+	if err = l.Conn(ctx).QueryRow(ctx, bucketQuery, key).Scan(&tat, &now); err != nil {
+		return Result{}, err
+	}
+	_, result := allow(limit, tat, now)
+	result.Allowed = false
+	return result, nil
+}
+
+// sweep removes refilled buckets, which are same as missing ones.
+func (l *postgresLimiter) sweep(ctx context.Context) error {
+	l.mu.Lock()
+	if time.Since(l.swept) < sweepInterval {
+		l.mu.Unlock()
+		return nil
+	}
+	l.swept = time.Now()
+	l.mu.Unlock()
+
+	const query = `DELETE FROM rate_limit WHERE tat < now()`
+
+	_, err := l.Conn(ctx).Exec(ctx, query)
+	return err
+}
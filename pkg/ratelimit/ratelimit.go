@@ -0,0 +1,109 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit allows burst of requests, which is refilled during period.
+// Zero limit is unlimited.
+type Limit struct {
+	Burst  int
+	Period time.Duration
+}
+
+var ErrLimitFormat = errors.New("limit must be formatted as <requests>/<period>, e.g. 10/1m")
+
+// ParseLimit parses limit formatted as <requests>/<period>, e.g. 10/1m.
+// "0" is unlimited.
+func ParseLimit(s string) (Limit, error) {
+	if s = strings.TrimSpace(s); s == "0" {
+		return Limit{}, nil
+	}
+
+	burst, period, ok := strings.Cut(s, "/")
+	if !ok {
+		return Limit{}, ErrLimitFormat
+	}
+
+	var limit Limit
+	var err error
+	if limit.Burst, err = strconv.Atoi(burst); err != nil || limit.Burst < 1 {
+		return Limit{}, ErrLimitFormat
+	}
+	if limit.Period, err = time.ParseDuration(period); err != nil || limit.Period <= 0 {
+		return Limit{}, ErrLimitFormat
+	}
+	return limit, nil
+}
+
+func (l Limit) Unlimited() bool { return l.Burst == 0 }
+
+func (l Limit) String() string {
+	if l.Unlimited() {
+		return "0"
+	}
+	return fmt.Sprintf("%d/%s", l.Burst, l.Period)
+}
+
+// interval returns time to refill one request.
+func (l Limit) interval() time.Duration { return l.Period / time.Duration(l.Burst) }
+
+// Limits are limits of routes with default limit of other routes.
+type Limits struct {
+	Default Limit
+	Routes  map[string]Limit
+}
+
+func (l *Limits) Route(route string) Limit {
+	if limit, ok := l.Routes[route]; ok {
+		return limit
+	}
+	return l.Default
+}
+
+// Result of request to limiter.
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	Reset      time.Duration // time until burst is refilled
+	RetryAfter time.Duration // time until request is allowed, if not allowed
+}
+
+// Limiter is token bucket, which allows request by key if it has tokens left.
+// Bucket is stored as theoretical arrival time of next request (GCRA).
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// allow returns new theoretical arrival time and result of request at now.
+func allow(limit Limit, tat time.Time, now time.Time) (time.Time, Result) {
+	interval := limit.interval()
+	if tat.Before(now) {
+		tat = now
+	}
+	next := tat.Add(interval)
+
+	result := Result{Limit: limit.Burst}
+	if over := next.Sub(now) - limit.Period; over > 0 {
+		result.Reset = tat.Sub(now)
+		result.Remaining = remaining(limit, result.Reset)
+		result.RetryAfter = over
+		return tat, result
+	}
+
+	result.Allowed = true
+	result.Reset = next.Sub(now)
+	result.Remaining = remaining(limit, result.Reset)
+	return next, result
+}
+
+func remaining(limit Limit, reset time.Duration) int {
+	return int(math.Floor(float64(limit.Period-reset) / float64(limit.interval())))
+}
@@ -0,0 +1,9 @@
+package tracing
+
+type Option func(*Tracing)
+
+func ServiceName(name string) Option {
+	return func(t *Tracing) {
+		t.serviceName = name
+	}
+}
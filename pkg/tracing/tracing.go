@@ -0,0 +1,77 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	ExporterOTLP   = "otlp"   // configured by standard OTEL_EXPORTER_OTLP_* env variables
+	ExporterStdout = "stdout" // writes spans as JSON lines
+	ExporterNone   = "none"
+)
+
+var Exporters = []string{ExporterOTLP, ExporterStdout, ExporterNone}
+
+// Tracing sets global tracer provider and W3C trace context propagator.
+type Tracing struct {
+	provider    *sdktrace.TracerProvider
+	serviceName string
+}
+
+func New(ctx context.Context, exporter string, opts ...Option) (*Tracing, error) {
+	t := &Tracing{}
+
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{}))
+
+	var spanExporter sdktrace.SpanExporter
+	var err error
+	switch exporter {
+	case ExporterOTLP:
+		spanExporter, err = otlptracehttp.New(ctx)
+	case ExporterStdout:
+		spanExporter, err = stdouttrace.New()
+	case ExporterNone:
+		return t, nil
+	default:
+		return nil, fmt.Errorf("tracing exporter must be one of: %v", Exporters)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(attribute.String("service.name", t.serviceName)),
+		resource.WithFromEnv())
+	if err != nil {
+		return nil, err
+	}
+
+	t.provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithResource(res))
+	otel.SetTracerProvider(t.provider)
+
+	return t, nil
+}
+
+// Shutdown flushes pending spans.
+func (t *Tracing) Shutdown(ctx context.Context) error {
+	if t.provider == nil {
+		return nil
+	}
+	return t.provider.Shutdown(ctx)
+}